@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/kelseyhightower/envconfig"
+	"github.com/proof-pass/proof-pass/backend/csvimport"
+	"github.com/proof-pass/proof-pass/backend/export"
+	"github.com/proof-pass/proof-pass/backend/repos"
+)
+
+const usage = `usage: app <command> [flags]
+
+commands:
+  export    export the registrations or attendance of an event
+  import    import the registrations of an event from a CSV of emails
+`
+
+// runCommand runs a CLI subcommand and exits with a non-zero status if it fails
+func runCommand(name string, args []string) {
+	var err error
+	switch name {
+	case "export":
+		err = runExport(args)
+	case "import":
+		err = runImport(args)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// connectDB connects to the database configured by the same environment variables as the server
+func connectDB(ctx context.Context) (*pgxpool.Pool, error) {
+	var cfg PostgresCfg
+	envconfig.MustProcess("backend", &cfg)
+
+	pool, err := pgxpool.New(ctx, cfg.connString())
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to database, %v", err)
+	}
+	return pool, nil
+}
+
+// runExport writes the registrations or attendance of an event to a file or stdout
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	eventID := flags.String("event", "", "ID of the event to export")
+	table := flags.String("table", "attendances", "table to export, registrations or attendances")
+	format := flags.String("format", export.FormatCSV, "output format, csv or ndjson")
+	columns := flags.String("columns", "", "comma separated columns to export, all columns if empty")
+	output := flags.String("o", "", "file to write to, stdout if empty")
+	flags.Parse(args)
+
+	if *eventID == "" {
+		flags.Usage()
+		return fmt.Errorf("-event is required")
+	}
+	var selected []string
+	if *columns != "" {
+		selected = strings.Split(*columns, ",")
+	}
+
+	ctx := context.Background()
+	pool, err := connectDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	dbClient := repos.NewClient(pool)
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	switch *table {
+	case "registrations":
+		return export.Registrations(dbClient.Registrations, *eventID).Write(ctx, w, *format, selected)
+	case "attendances":
+		return export.Attendances(dbClient.Attendances, *eventID).Write(ctx, w, *format, selected)
+	default:
+		return fmt.Errorf("unknown table %q", *table)
+	}
+}
+
+// runImport registers the emails of a CSV file for an event and prints a report
+func runImport(args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	eventID := flags.String("event", "", "ID of the event to import registrations for")
+	file := flags.String("file", "", "CSV file with an email column, or with the emails in the first column")
+	sync := flags.Bool("sync", false, "remove registrations and waitlist entries of emails that are not in the file")
+	flags.Parse(args)
+
+	if *eventID == "" || *file == "" {
+		flags.Usage()
+		return fmt.Errorf("-event and -file are required")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	emails, invalid, err := csvimport.ParseEmails(f)
+	if err != nil {
+		return fmt.Errorf("invalid CSV file, %v", err)
+	}
+
+	ctx := context.Background()
+	pool, err := connectDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	dbClient := repos.NewClient(pool)
+
+	if _, err := dbClient.Events.GetEventByID(ctx, *eventID); err != nil {
+		return fmt.Errorf("unable to get event %s, %v", *eventID, err)
+	}
+	report, err := csvimport.ImportRegistrations(ctx, dbClient, *eventID, emails, *sync)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("added: %d, unchanged: %d, waitlisted: %d, removed: %d, revoked tickets: %d, removed from waitlist: %d, invalid: %d\n", report.Added, report.Unchanged, report.Waitlisted, report.Removed, report.Revoked, report.RemovedFromWaitlist, len(invalid))
+	for _, row := range invalid {
+		fmt.Printf("line %d: %s %q\n", row.Line, row.Reason, row.Value)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package csvimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/proof-pass/proof-pass/backend/repos"
+	"github.com/proof-pass/proof-pass/backend/repos/event_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/issued_tickets"
+	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/waitlist"
+)
+
+// MaxRows limits the size of a single import
+const MaxRows = 100000
+
+// RevocationReasonRemoved is recorded with the tickets revoked because their registration was removed by a sync
+const RevocationReasonRemoved = "registration removed by import"
+
+var (
+	ErrTooManyRows   = fmt.Errorf("file has more than %d rows", MaxRows)
+	ErrNothingToSync = errors.New("file has no valid emails, refusing to remove every registration")
+)
+
+// InvalidRow is a row that was skipped
+type InvalidRow struct {
+	Line   int
+	Value  string
+	Reason string
+}
+
+// Report counts the changes made by an import
+type Report struct {
+	Added     int
+	Unchanged int
+	Removed   int
+	// Revoked counts the tickets revoked along with the removed registrations
+	Revoked int
+	// Waitlisted counts the emails put on the waitlist because the event was full
+	Waitlisted int
+	// RemovedFromWaitlist counts the waitlisted emails removed by a sync
+	RemovedFromWaitlist int
+	Invalid             []InvalidRow
+}
+
+// ParseEmails reads the emails of a CSV file. The file either has a header row with an "email" column,
+// or the emails are in the first column. Rows with an invalid or repeated email are returned as invalid.
+func ParseEmails(r io.Reader) (emails []string, invalid []InvalidRow, err error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	column := 0
+	seen := map[string]bool{}
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if row >= MaxRows {
+			return nil, nil, ErrTooManyRows
+		}
+		line, _ := reader.FieldPos(0)
+
+		if row == 0 {
+			if i := headerColumn(record); i >= 0 {
+				column = i
+				continue
+			}
+		}
+		// blank lines are skipped by the reader
+		if column >= len(record) || strings.TrimSpace(record[column]) == "" {
+			invalid = append(invalid, InvalidRow{line, "", "Missing email"})
+			continue
+		}
+
+		value := strings.TrimSpace(record[column])
+		address, err := mail.ParseAddress(value)
+		if err != nil {
+			invalid = append(invalid, InvalidRow{line, value, "Invalid email"})
+			continue
+		}
+		if seen[address.Address] {
+			invalid = append(invalid, InvalidRow{line, value, "Duplicate email"})
+			continue
+		}
+		seen[address.Address] = true
+		emails = append(emails, address.Address)
+	}
+	return emails, invalid, nil
+}
+
+// headerColumn returns the index of the email column if the record is a header, or -1
+func headerColumn(record []string) int {
+	for i, field := range record {
+		if strings.EqualFold(strings.TrimSpace(field), "email") {
+			return i
+		}
+	}
+	return -1
+}
+
+// ImportRegistrations registers the emails for the event, emails that are already registered are left
+// unchanged. In sync mode, registrations of emails that are not in the list are cancelled:
+// they are removed, and their stored tickets deleted and issued tickets revoked. Their waitlist entries
+// are removed too, so that they are not registered again from the waitlist.
+// Once the event is at capacity, the remaining emails are put on the waitlist in file order.
+func ImportRegistrations(ctx context.Context, dbClient *repos.Client, eventID string, emails []string, sync bool) (*Report, error) {
+	if sync && len(emails) == 0 {
+		return nil, ErrNothingToSync
+	}
+
+	tx, err := dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction, %v", err)
+	}
+	defer tx.Rollback(ctx)
+	q := dbClient.Registrations.WithTx(tx)
+
+	// the event is locked so the places counted here are not given out by a concurrent registration
+	event, err := dbClient.Events.WithTx(tx).LockEventByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock event, %v", err)
+	}
+
+	report := &Report{}
+	if sync {
+		removed, err := q.DeleteEventRegistrationsNotIn(ctx, registrations.DeleteEventRegistrationsNotInParams{
+			EventID: eventID,
+			Emails:  emails,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove registrations, %v", err)
+		}
+		report.Removed = len(removed)
+
+		removedEmails := make([]string, len(removed))
+		for i, registration := range removed {
+			removedEmails[i] = registration.Email
+		}
+		if _, err := dbClient.TicketCredentials.WithTx(tx).DeleteByEventIdAndEmails(ctx, ticket_credentials.DeleteByEventIdAndEmailsParams{
+			EventID: eventID,
+			Emails:  removedEmails,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to delete ticket credentials, %v", err)
+		}
+		if _, err := dbClient.EventCredentials.WithTx(tx).DeletePreIssuedByEventIdAndEmails(ctx, event_credentials.DeletePreIssuedByEventIdAndEmailsParams{
+			EventID: eventID,
+			Emails:  removedEmails,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to delete pre-issued tickets, %v", err)
+		}
+		revoked, err := dbClient.IssuedTickets.WithTx(tx).RevokeByEventIdAndEmails(ctx, issued_tickets.RevokeByEventIdAndEmailsParams{
+			EventID:          eventID,
+			Emails:           removedEmails,
+			RevocationReason: RevocationReasonRemoved,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to revoke tickets, %v", err)
+		}
+		report.Revoked = len(revoked)
+
+		unwaitlisted, err := dbClient.Waitlist.WithTx(tx).DeleteByEventIdNotInEmails(ctx, waitlist.DeleteByEventIdNotInEmailsParams{
+			EventID: eventID,
+			Emails:  emails,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove from waitlist, %v", err)
+		}
+		report.RemovedFromWaitlist = int(unwaitlisted)
+	}
+
+	// registrations removed by a sync free their places before the new emails are added
+	registered, err := q.CountEventRegistrations(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count registrations, %v", err)
+	}
+	for _, email := range emails {
+		_, err := q.GetOneByEventIdAndEmail(ctx, registrations.GetOneByEventIdAndEmailParams{
+			EventID: eventID,
+			Email:   email,
+		})
+		if err == nil {
+			report.Unchanged++
+			continue
+		}
+		if err != pgx.ErrNoRows {
+			return nil, fmt.Errorf("failed to get registration, %v", err)
+		}
+
+		if event.Capacity.Valid && registered >= int64(event.Capacity.Int32) {
+			if _, err := dbClient.Waitlist.WithTx(tx).AddToWaitlist(ctx, waitlist.AddToWaitlistParams{
+				EventID: eventID,
+				Email:   email,
+			}); err != nil && err != pgx.ErrNoRows {
+				return nil, fmt.Errorf("failed to add to waitlist, %v", err)
+			}
+			report.Waitlisted++
+			continue
+		}
+		if _, err := q.CreateOrIgnoreRegistration(ctx, registrations.CreateOrIgnoreRegistrationParams{
+			EventID: eventID,
+			Email:   email,
+		}); err != nil {
+			return nil, fmt.Errorf("failed to create registration, %v", err)
+		}
+		registered++
+		report.Added++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit import, %v", err)
+	}
+	return report, nil
+}
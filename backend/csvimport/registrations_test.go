@@ -0,0 +1,34 @@
+package csvimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEmails(t *testing.T) {
+	file := "name,Email\n" +
+		"Alice,alice@example.com\n" +
+		"Bob,Bob <bob@example.com>\n" +
+		"\n" +
+		"Carol,not an email\n" +
+		"Alice again,alice@example.com\n" +
+		"Dave\n"
+
+	emails, invalid, err := ParseEmails(strings.NewReader(file))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com", "bob@example.com"}, emails)
+	assert.Equal(t, []InvalidRow{
+		{5, "not an email", "Invalid email"},
+		{6, "alice@example.com", "Duplicate email"},
+		{7, "", "Missing email"},
+	}, invalid)
+}
+
+func TestParseEmails_NoHeader(t *testing.T) {
+	emails, invalid, err := ParseEmails(strings.NewReader("alice@example.com\nbob@example.com\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"alice@example.com", "bob@example.com"}, emails)
+	assert.Empty(t, invalid)
+}
@@ -0,0 +1,252 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/proof-pass/proof-pass/backend/repos/attendances"
+	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+)
+
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// number of rows read from the database at a time
+const pageSize = 1000
+
+var (
+	ErrUnknownFormat = errors.New("unknown export format")
+	ErrUnknownColumn = errors.New("unknown export column")
+)
+
+type column[T any] struct {
+	name  string
+	value func(T) interface{}
+}
+
+// Table is an exportable table of an event, rows are read page by page and written as they are read
+type Table[T any] struct {
+	Name    string
+	columns []column[T]
+	id      func(T) int32
+	page    func(ctx context.Context, afterID int32) ([]T, error)
+}
+
+// Registrations exports the registered emails of an event
+func Registrations(q *registrations.Queries, eventID string) *Table[registrations.Registration] {
+	return &Table[registrations.Registration]{
+		Name: "registrations",
+		columns: []column[registrations.Registration]{
+			{"id", func(r registrations.Registration) interface{} { return r.ID }},
+			{"event_id", func(r registrations.Registration) interface{} { return r.EventID }},
+			{"email", func(r registrations.Registration) interface{} { return r.Email }},
+			{"tier", func(r registrations.Registration) interface{} { return r.Tier }},
+		},
+		id: func(r registrations.Registration) int32 { return r.ID },
+		page: func(ctx context.Context, afterID int32) ([]registrations.Registration, error) {
+			return q.GetEventRegistrationsPage(ctx, registrations.GetEventRegistrationsPageParams{
+				EventID:  eventID,
+				AfterID:  afterID,
+				PageSize: pageSize,
+			})
+		},
+	}
+}
+
+// Attendances exports the check-ins of an event. Nullifiers are left out so that rows cannot be
+// matched with proofs, or with registrations.
+func Attendances(q *attendances.Queries, eventID string) *Table[attendances.Attendance] {
+	return &Table[attendances.Attendance]{
+		Name: "attendances",
+		columns: []column[attendances.Attendance]{
+			{"event_id", func(a attendances.Attendance) interface{} { return a.EventID }},
+			{"checked_in_at", func(a attendances.Attendance) interface{} { return a.CreatedAt.Time }},
+			{"last_scanned_at", func(a attendances.Attendance) interface{} { return a.LastScannedAt.Time }},
+			{"scan_count", func(a attendances.Attendance) interface{} { return a.ScanCount }},
+			{"scanner_id", func(a attendances.Attendance) interface{} { return a.ScannerID.String }},
+		},
+		id: func(a attendances.Attendance) int32 { return a.ID },
+		page: func(ctx context.Context, afterID int32) ([]attendances.Attendance, error) {
+			return q.GetPageByEventId(ctx, attendances.GetPageByEventIdParams{
+				EventID:  eventID,
+				AfterID:  afterID,
+				PageSize: pageSize,
+			})
+		},
+	}
+}
+
+// ContentType returns the media type of the format
+func ContentType(format string) string {
+	if format == FormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv"
+}
+
+// Validate checks the format and the selected columns before anything is written
+func (t *Table[T]) Validate(format string, columns []string) error {
+	if format != FormatCSV && format != FormatNDJSON {
+		return fmt.Errorf("%w %q", ErrUnknownFormat, format)
+	}
+	_, err := t.selectColumns(columns)
+	return err
+}
+
+// Write streams every row of the table to w in the given format. Only the selected columns are
+// written, in the given order, or all columns if none are selected.
+func (t *Table[T]) Write(ctx context.Context, w io.Writer, format string, columns []string) error {
+	if err := t.Validate(format, columns); err != nil {
+		return err
+	}
+	selected, _ := t.selectColumns(columns)
+	cols := t.columnsByName(selected)
+
+	var rw rowWriter
+	if format == FormatNDJSON {
+		rw = &ndjsonWriter{w: bufio.NewWriter(w), columns: selected}
+	} else {
+		rw = &csvWriter{w: csv.NewWriter(w)}
+	}
+
+	if err := rw.header(selected); err != nil {
+		return err
+	}
+	afterID := int32(0)
+	for {
+		rows, err := t.page(ctx, afterID)
+		if err != nil {
+			return fmt.Errorf("failed to read %s, %v", t.Name, err)
+		}
+		for _, row := range rows {
+			values := make([]interface{}, len(selected))
+			for i, c := range cols {
+				values[i] = c.value(row)
+			}
+			if err := rw.row(values); err != nil {
+				return err
+			}
+		}
+		// flush every page so that the client receives rows as they are read
+		if err := rw.flush(); err != nil {
+			return err
+		}
+		if f, ok := w.(interface{ Flush() }); ok {
+			f.Flush()
+		}
+		if len(rows) < pageSize {
+			return nil
+		}
+		afterID = t.id(rows[len(rows)-1])
+	}
+}
+
+func (t *Table[T]) selectColumns(names []string) ([]string, error) {
+	if len(names) == 0 {
+		all := make([]string, len(t.columns))
+		for i, c := range t.columns {
+			all[i] = c.name
+		}
+		return all, nil
+	}
+	for _, name := range names {
+		if len(t.columnsByName([]string{name})) == 0 {
+			return nil, fmt.Errorf("%w %q", ErrUnknownColumn, name)
+		}
+	}
+	return names, nil
+}
+
+func (t *Table[T]) columnsByName(names []string) []column[T] {
+	columns := make([]column[T], 0, len(names))
+	for _, name := range names {
+		for _, c := range t.columns {
+			if c.name == name {
+				columns = append(columns, c)
+				break
+			}
+		}
+	}
+	return columns
+}
+
+type rowWriter interface {
+	header(columns []string) error
+	row(values []interface{}) error
+	flush() error
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) header(columns []string) error {
+	return c.w.Write(columns)
+}
+
+func (c *csvWriter) row(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case string:
+			record[i] = v
+		case int32:
+			record[i] = strconv.FormatInt(int64(v), 10)
+		case time.Time:
+			record[i] = v.UTC().Format(time.RFC3339)
+		default:
+			record[i] = fmt.Sprint(v)
+		}
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// ndjsonWriter writes one JSON object per row, keeping the order of the selected columns
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns []string
+}
+
+func (n *ndjsonWriter) header(columns []string) error {
+	return nil
+}
+
+func (n *ndjsonWriter) row(values []interface{}) error {
+	n.w.WriteByte('{')
+	for i, value := range values {
+		if i > 0 {
+			n.w.WriteByte(',')
+		}
+		key, err := json.Marshal(n.columns[i])
+		if err != nil {
+			return err
+		}
+		v, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		n.w.Write(key)
+		n.w.WriteByte(':')
+		n.w.Write(v)
+	}
+	_, err := n.w.WriteString("}\n")
+	return err
+}
+
+func (n *ndjsonWriter) flush() error {
+	return n.w.Flush()
+}
@@ -0,0 +1,59 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/repos/attendances"
+	"github.com/stretchr/testify/assert"
+)
+
+func testAttendances(count int) *Table[attendances.Attendance] {
+	table := Attendances(nil, "event")
+	table.page = func(ctx context.Context, afterID int32) ([]attendances.Attendance, error) {
+		var rows []attendances.Attendance
+		for id := afterID + 1; id <= int32(count) && len(rows) < pageSize; id++ {
+			rows = append(rows, attendances.Attendance{
+				ID:        id,
+				EventID:   "event",
+				Nullifier: "secret",
+				CreatedAt: pgtype.Timestamptz{Time: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC), Valid: true},
+				ScanCount: 1,
+			})
+		}
+		return rows, nil
+	}
+	return table
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	err := testAttendances(2).Write(context.Background(), &buf, FormatCSV, []string{"scan_count", "checked_in_at"})
+	assert.NoError(t, err)
+	assert.Equal(t, "scan_count,checked_in_at\n1,2024-05-01T10:00:00Z\n1,2024-05-01T10:00:00Z\n", buf.String())
+}
+
+func TestWriteNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	err := testAttendances(1).Write(context.Background(), &buf, FormatNDJSON, []string{"event_id", "scan_count"})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"event_id\":\"event\",\"scan_count\":1}\n", buf.String())
+}
+
+func TestWritePages(t *testing.T) {
+	var buf bytes.Buffer
+	err := testAttendances(pageSize+1).Write(context.Background(), &buf, FormatCSV, []string{"event_id"})
+	assert.NoError(t, err)
+	assert.Equal(t, pageSize+2, bytes.Count(buf.Bytes(), []byte("\n")))
+	assert.NotContains(t, buf.String(), "secret")
+}
+
+func TestValidate(t *testing.T) {
+	table := testAttendances(0)
+	assert.NoError(t, table.Validate(FormatCSV, nil))
+	assert.ErrorIs(t, table.Validate("xml", nil), ErrUnknownFormat)
+	assert.ErrorIs(t, table.Validate(FormatNDJSON, []string{"nullifier"}), ErrUnknownColumn)
+}
@@ -51,3 +51,6 @@ require (
 	google.golang.org/protobuf v1.34.2 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
+
+// the backend is built against the stubs of the issuer in this repository, so new RPCs ship with their callers
+replace github.com/proof-pass/proof-pass/issuer/api/go => ../issuer/api/go
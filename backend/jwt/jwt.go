@@ -1,28 +1,59 @@
 package jwt
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt"
 )
 
 type Service struct {
-	secretKey []byte
-	expireSec int64
+	secretKey   []byte
+	expireSec   int64
+	manifestKey ed25519.PrivateKey
 }
 
+// Claims identify the user or scanner of a token. Organization roles are not
+// carried in the token, they are looked up from the user ID on each request so
+// that a removed member loses access before the token expires.
 type Claims struct {
 	ID    string `json:"id"`
 	Email string `json:"email"`
+	// set only on scanner tokens, which are scoped to a single event
+	EventID   string `json:"event_id,omitempty"`
+	ScannerID string `json:"scanner_id,omitempty"`
 	jwt.StandardClaims
 }
 
-// NewService creates a new JWT service with the given secret key
-func NewService(secretKey string, expireSec int64) *Service {
+// ManifestClaims carries an event manifest signed for offline scanners
+type ManifestClaims struct {
+	Manifest interface{} `json:"manifest"`
+	jwt.StandardClaims
+}
+
+// NewService creates a new JWT service with the given secret key.
+// Manifests are signed with the given Ed25519 key so that scanners can verify
+// them with the public key alone, they are not signed if the key is nil.
+func NewService(secretKey string, manifestKey ed25519.PrivateKey, expireSec int64) *Service {
 	return &Service{
-		secretKey: []byte(secretKey),
-		expireSec: expireSec,
+		secretKey:   []byte(secretKey),
+		expireSec:   expireSec,
+		manifestKey: manifestKey,
+	}
+}
+
+// ParseManifestKey parses the hex encoded seed of the Ed25519 key manifests are signed with
+func ParseManifestKey(seed string) (ed25519.PrivateKey, error) {
+	b, err := hex.DecodeString(seed)
+	if err != nil {
+		return nil, fmt.Errorf("manifest key is not hex encoded, %v", err)
+	}
+	if len(b) != ed25519.SeedSize {
+		return nil, fmt.Errorf("manifest key must be %d bytes, got %d", ed25519.SeedSize, len(b))
 	}
+	return ed25519.NewKeyFromSeed(b), nil
 }
 
 func (s *Service) GenerateJWT(id string, email string) (string, error) {
@@ -39,9 +70,58 @@ func (s *Service) GenerateJWT(id string, email string) (string, error) {
 	return token.SignedString(s.secretKey)
 }
 
+// GenerateScannerJWT creates a token for a scanner device that is valid until expiresAt
+func (s *Service) GenerateScannerJWT(scannerID string, eventID string, expiresAt time.Time) (string, error) {
+	claims := &Claims{
+		EventID:   eventID,
+		ScannerID: scannerID,
+		StandardClaims: jwt.StandardClaims{
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(s.secretKey)
+}
+
+// SignsManifests reports whether a manifest key is configured
+func (s *Service) SignsManifests() bool {
+	return s.manifestKey != nil
+}
+
+// GenerateManifestJWT signs the manifest of an event, the signature is valid until expiresAt
+func (s *Service) GenerateManifestJWT(eventID string, manifest interface{}, expiresAt time.Time) (string, error) {
+	if !s.SignsManifests() {
+		return "", fmt.Errorf("manifest key not configured")
+	}
+	claims := &ManifestClaims{
+		Manifest: manifest,
+		StandardClaims: jwt.StandardClaims{
+			Subject:   eventID,
+			IssuedAt:  time.Now().Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	return token.SignedString(s.manifestKey)
+}
+
+// ManifestPublicKey returns the key scanners use to verify signed manifests
+func (s *Service) ManifestPublicKey() ed25519.PublicKey {
+	if !s.SignsManifests() {
+		return nil
+	}
+	return s.manifestKey.Public().(ed25519.PublicKey)
+}
+
 func (s *Service) ValidateJWT(tokenString string) (*Claims, error) {
 	claims := &Claims{}
 	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		// manifests are signed with a different method and must not be accepted as tokens
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
 		return s.secretKey, nil
 	})
 	if err != nil {
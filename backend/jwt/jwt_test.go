@@ -1,22 +1,33 @@
 package jwt
 
 import (
+	"crypto/ed25519"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
-	testSecretKey = "mysecretkey"
-	testExpireSec = 3600
-	testUserID    = "12345"
-	testUserEmail = "user@example.com"
+	testSecretKey   = "mysecretkey"
+	testManifestKey = "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"
+	testExpireSec   = 3600
+	testUserID      = "12345"
+	testUserEmail   = "user@example.com"
+	testEventID     = "67890"
+	testScannerID   = "abcde"
 )
 
+func newTestService(t *testing.T) *Service {
+	manifestKey, err := ParseManifestKey(testManifestKey)
+	require.NoError(t, err)
+	return NewService(testSecretKey, manifestKey, testExpireSec)
+}
+
 func TestGenerateJWT(t *testing.T) {
-	jwtService := NewService(testSecretKey, testExpireSec)
+	jwtService := newTestService(t)
 
 	tokenString, err := jwtService.GenerateJWT(testUserID, testUserEmail)
 	assert.NoError(t, err)
@@ -37,7 +48,7 @@ func TestGenerateJWT(t *testing.T) {
 }
 
 func TestValidateJWT(t *testing.T) {
-	jwtService := NewService(testSecretKey, testExpireSec)
+	jwtService := newTestService(t)
 
 	// Generate a token to validate
 	tokenString, err := jwtService.GenerateJWT(testUserID, testUserEmail)
@@ -49,11 +60,77 @@ func TestValidateJWT(t *testing.T) {
 	assert.Equal(t, testUserEmail, claims.Email)
 }
 
+func TestGenerateScannerJWT(t *testing.T) {
+	jwtService := newTestService(t)
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	tokenString, err := jwtService.GenerateScannerJWT(testScannerID, testEventID, expiresAt)
+	assert.NoError(t, err)
+
+	claims, err := jwtService.ValidateJWT(tokenString)
+	assert.NoError(t, err)
+	assert.Equal(t, testScannerID, claims.ScannerID)
+	assert.Equal(t, testEventID, claims.EventID)
+	assert.Empty(t, claims.ID)
+	assert.Empty(t, claims.Email)
+	assert.Equal(t, expiresAt.Unix(), claims.ExpiresAt)
+}
+
 func TestValidateJWT_InvalidToken(t *testing.T) {
-	jwtService := NewService(testSecretKey, testExpireSec)
+	jwtService := newTestService(t)
 
 	invalidToken := "invalid.token.string"
 
 	_, err := jwtService.ValidateJWT(invalidToken)
 	assert.Error(t, err)
 }
+
+func TestGenerateManifestJWT(t *testing.T) {
+	jwtService := newTestService(t)
+	expiresAt := time.Now().Add(24 * time.Hour)
+
+	tokenString, err := jwtService.GenerateManifestJWT(testEventID, map[string]string{"event_id": testEventID}, expiresAt)
+	assert.NoError(t, err)
+
+	// verify with the public key only
+	claims := &ManifestClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		return jwtService.ManifestPublicKey(), nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, token.Valid)
+	assert.Equal(t, testEventID, claims.Subject)
+	assert.Equal(t, map[string]interface{}{"event_id": testEventID}, claims.Manifest)
+
+	// the key is configured so it is stable across instances
+	assert.Equal(t, jwtService.ManifestPublicKey(), newTestService(t).ManifestPublicKey())
+
+	// manifests must not be accepted as bearer tokens
+	_, err = jwtService.ValidateJWT(tokenString)
+	assert.Error(t, err)
+}
+
+func TestParseManifestKey(t *testing.T) {
+	key, err := ParseManifestKey(testManifestKey)
+	assert.NoError(t, err)
+	assert.Len(t, key, ed25519.PrivateKeySize)
+
+	// the key does not depend on the JWT secret
+	other, err := ParseManifestKey("4ccd089b28ff96da9db6c346ec114e0f5b8a319f35aba624da8cf6ed4fb8a6fb")
+	assert.NoError(t, err)
+	assert.NotEqual(t, key.Public(), other.Public())
+
+	_, err = ParseManifestKey("not hex")
+	assert.Error(t, err)
+	_, err = ParseManifestKey("9d61b19d")
+	assert.Error(t, err)
+}
+
+func TestGenerateManifestJWT_NoKey(t *testing.T) {
+	jwtService := NewService(testSecretKey, nil, testExpireSec)
+
+	assert.False(t, jwtService.SignsManifests())
+	assert.Nil(t, jwtService.ManifestPublicKey())
+	_, err := jwtService.GenerateManifestJWT(testEventID, map[string]string{"event_id": testEventID}, time.Now().Add(time.Hour))
+	assert.Error(t, err)
+}
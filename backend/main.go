@@ -2,7 +2,9 @@ package main
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
+	"os"
 
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
@@ -19,23 +21,43 @@ import (
 	"google.golang.org/grpc"
 )
 
+// PostgresCfg is shared by the server and the CLI subcommands
+type PostgresCfg struct {
+	PostgresHost     string `default:"postgres.app.svc.cluster.local"`
+	PostgresDatabase string `default:"db"`
+	PostgresPort     int    `default:"5432"`
+	PostgresUsername string `required:"true"`
+	PostgresPassword string `required:"true"`
+}
+
+func (c PostgresCfg) connString() string {
+	return fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable", c.PostgresHost, c.PostgresPort, c.PostgresUsername, c.PostgresPassword, c.PostgresDatabase)
+}
+
 type appCfg struct {
-	RestPort                 int    `default:"3000"`
-	PostgresHost             string `default:"postgres.app.svc.cluster.local"`
-	PostgresDatabase         string `default:"db"`
-	PostgresPort             int    `default:"5432"`
-	PostgresUsername         string `required:"true"`
-	PostgresPassword         string `required:"true"`
-	RedisAddr                string `default:"redis-master:6379"`
-	IssuerAddr               string `default:"issuer.app.svc.cluster.local:9090"`
-	IssuerChainID            int64  `required:"true"`
-	EmailCredentialContextID int64  `default:"111"` // TODO: change to actual context ID and set to requried
-	JWTSecretKey             string `required:"true"`
-	JWTExpiresSec            int64  `required:"true"`
-	EnableLoginEmail         bool   `required:"true"`
+	RestPort int `default:"3000"`
+	PostgresCfg
+	RedisAddr                   string   `default:"redis-master:6379"`
+	IssuerAddr                  string   `default:"issuer.app.svc.cluster.local:9090"`
+	IssuerChainID               int64    `required:"true"` // chain email credentials are signed for, tickets use the chain of their event
+	AllowedIssuerKeyIDs         []string // issuer keys accepted at check-in in addition to each event's own key
+	AdminEmails                 []string // users allowed to create and manage events
+	EmailCredentialContextID    int64    `default:"111"` // TODO: change to actual context ID and set to requried
+	EmailCredentialTypeID       string   `default:"1"`   // unit type of email credentials in the credential type registry
+	EmailDomainCredentialTypeID string   `default:"3"`   // property type of email domain credentials in the credential type registry
+	JWTSecretKey                string   `required:"true"`
+	JWTExpiresSec               int64    `required:"true"`
+	ManifestSigningKey          string   // hex encoded Ed25519 seed signing event manifests for offline scanners, unsigned if empty
+	EnableLoginEmail            bool     `required:"true"`
 }
 
 func main() {
+	// run a CLI subcommand instead of the server if one is given
+	if len(os.Args) > 1 {
+		runCommand(os.Args[1], os.Args[2:])
+		return
+	}
+
 	// load the configuration from the environment variables
 	var cfg appCfg
 	envconfig.MustProcess("backend", &cfg)
@@ -46,7 +68,7 @@ func main() {
 	zerolog.DefaultContextLogger = &log.Logger
 
 	// connect to the database
-	pool, err := pgxpool.New(context.Background(), fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable", cfg.PostgresHost, cfg.PostgresPort, cfg.PostgresUsername, cfg.PostgresPassword, cfg.PostgresDatabase))
+	pool, err := pgxpool.New(context.Background(), cfg.connString())
 	if err != nil {
 		log.Fatal().Msgf("Unable to connect to database: %v", err)
 	}
@@ -67,7 +89,16 @@ func main() {
 	}
 
 	// initialize JWT service
-	jwtService := jwt.NewService(cfg.JWTSecretKey, cfg.JWTExpiresSec)
+	var manifestKey ed25519.PrivateKey
+	if cfg.ManifestSigningKey != "" {
+		manifestKey, err = jwt.ParseManifestKey(cfg.ManifestSigningKey)
+		if err != nil {
+			log.Fatal().Msgf("Invalid manifest signing key: %v", err)
+		}
+	} else {
+		log.Warn().Msg("Manifest signing key not set, manifests are served unsigned")
+	}
+	jwtService := jwt.NewService(cfg.JWTSecretKey, manifestKey, cfg.JWTExpiresSec)
 
 	// initialize issuer client at issuer.app.svc.cluster.local:9090
 	issuerConn, err := grpc.NewClient(cfg.IssuerAddr, grpc.WithInsecure())
@@ -79,7 +110,11 @@ func main() {
 	// initialize API service
 	apiService := service.NewAPIService(
 		cfg.EmailCredentialContextID,
+		cfg.EmailCredentialTypeID,
+		cfg.EmailDomainCredentialTypeID,
 		cfg.IssuerChainID,
+		cfg.AllowedIssuerKeyIDs,
+		cfg.AdminEmails,
 		dbClient,
 		redisClient,
 		sesClient,
@@ -0,0 +1,4 @@
+ALTER TABLE events
+    ADD COLUMN registration_mode VARCHAR NOT NULL DEFAULT 'invite_only' CHECK (registration_mode IN ('invite_only', 'open', 'domain')),
+    ADD COLUMN allowed_email_domains VARCHAR[] NOT NULL DEFAULT '{}',
+    ADD COLUMN registration_deadline TIMESTAMPTZ;
@@ -0,0 +1,6 @@
+-- existing events stay listed, new events start as drafts
+ALTER TABLE events
+    ADD COLUMN status VARCHAR NOT NULL DEFAULT 'published' CHECK (status IN ('draft', 'published', 'cancelled', 'archived')),
+    ADD COLUMN publish_at TIMESTAMPTZ;
+
+ALTER TABLE events ALTER COLUMN status SET DEFAULT 'draft';
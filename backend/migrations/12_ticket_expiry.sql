@@ -0,0 +1,4 @@
+-- existing events keep a fixed validity, one year instead of the 265 days tickets were issued with
+ALTER TABLE events
+    ADD COLUMN ticket_expiry_policy VARCHAR NOT NULL DEFAULT 'fixed_ttl' CHECK (ticket_expiry_policy IN ('fixed_ttl', 'event_end')),
+    ADD COLUMN ticket_validity_seconds INTEGER NOT NULL DEFAULT 31536000 CHECK (ticket_validity_seconds >= 0);
@@ -0,0 +1,5 @@
+ALTER TABLE registrations
+    ADD COLUMN tier VARCHAR NOT NULL DEFAULT 'general' CHECK (tier IN ('general', 'vip', 'speaker'));
+
+ALTER TABLE events
+    ADD COLUMN tiered_tickets BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,15 @@
+CREATE TABLE credential_types (
+    type_id VARCHAR PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    organization_id VARCHAR REFERENCES organizations(id) ON DELETE CASCADE,
+    claims JSONB NOT NULL DEFAULT '[]',
+    revocable BOOLEAN NOT NULL DEFAULT FALSE,
+    default_ttl_seconds INTEGER NOT NULL CHECK (default_ttl_seconds > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- primitive types of the protocol, they have no organization and every organization can use them
+INSERT INTO credential_types (type_id, name, claims, revocable, default_ttl_seconds)
+VALUES ('1', 'unit', '[]', TRUE, 31536000),
+    ('2', 'scalar', '[{"name": "val", "type": "scalar", "width": 256}]', TRUE, 31536000),
+    ('3', 'property', '[{"name": "val", "type": "property", "width": 248, "equal_checks": 1}]', FALSE, 1209600);
@@ -0,0 +1,17 @@
+CREATE TABLE event_credentials (
+    id SERIAL PRIMARY KEY,
+    event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    email VARCHAR NOT NULL,
+    type_id VARCHAR NOT NULL REFERENCES credential_types(type_id),
+    claim_values VARCHAR[] NOT NULL DEFAULT '{}',
+    credential_id VARCHAR,
+    credential TEXT,
+    issued_at TIMESTAMPTZ,
+    expire_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(event_id, email, type_id)
+);
+
+-- credentials are queued until the recipient sets an identity commitment
+CREATE INDEX idx_event_credentials_queued ON event_credentials(email)
+WHERE credential IS NULL;
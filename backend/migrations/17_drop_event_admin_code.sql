@@ -0,0 +1,3 @@
+-- scanners are registered and revoked by organization admins, the shared event code is no longer used
+ALTER TABLE events
+    DROP COLUMN admin_code;
@@ -0,0 +1,13 @@
+-- Every event trusts the same issuer keys, so tickets are only bound to their event by the context.
+-- Context IDs are assigned by the server from now on. Events sharing a context with an older event
+-- get a new one, tickets issued for them before this migration are no longer accepted.
+UPDATE events e
+SET context_id = (('x' || substr(md5(e.id), 1, 15))::bit(60)::bigint)::text
+WHERE EXISTS (
+        SELECT 1
+        FROM events o
+        WHERE o.context_id = e.context_id
+            AND (o.created_at, o.id) < (e.created_at, e.id)
+    );
+
+CREATE UNIQUE INDEX idx_events_context_id ON events(context_id);
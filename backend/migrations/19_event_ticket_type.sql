@@ -0,0 +1,13 @@
+-- Tickets are of a type of the credential type registry, chosen per event. Existing events keep the
+-- primitive type their tickets were issued with.
+ALTER TABLE events
+    ADD COLUMN ticket_type_id VARCHAR REFERENCES credential_types(type_id);
+
+UPDATE events
+SET ticket_type_id = CASE
+        WHEN tiered_tickets THEN '2'
+        ELSE '1'
+    END;
+
+ALTER TABLE events
+    ALTER COLUMN ticket_type_id SET NOT NULL;
@@ -0,0 +1,13 @@
+-- Organizers issue credentials of the types registered for the event. Events keep the types they
+-- already issued, other than their ticket type.
+ALTER TABLE events
+    ADD COLUMN credential_type_ids VARCHAR[] NOT NULL DEFAULT '{}';
+
+UPDATE events e
+SET credential_type_ids = ARRAY(
+        SELECT DISTINCT c.type_id
+        FROM event_credentials c
+        WHERE c.event_id = e.id
+            AND c.type_id <> e.ticket_type_id
+        ORDER BY c.type_id
+    );
@@ -0,0 +1,6 @@
+-- Revoked tickets are only rejected at events that opt in, since check-in proofs then reveal the ticket
+-- ID, which links the attendance to the email the ticket was issued to. Existing events do not check
+-- revocation, their tickets were issued before issued_tickets and would all be rejected. Tickets that are
+-- not in issued_tickets, or were issued for another event, are rejected by events checking revocation.
+ALTER TABLE events
+    ADD COLUMN revocation_check BOOLEAN NOT NULL DEFAULT FALSE;
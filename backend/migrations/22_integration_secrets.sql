@@ -0,0 +1,17 @@
+-- Webhooks are signed with a secret of their integration and delivered to its own URL, instead of a
+-- secret shared by every organization. An external event is then bound to an integration by the
+-- platform account that sends its orders, so several events can link the same external event without
+-- one of them receiving the orders of another. Existing integrations get a new secret, which their
+-- organizers find in the integrations of the event and set on the platform.
+ALTER TABLE event_integrations
+    ADD COLUMN secret VARCHAR;
+
+UPDATE event_integrations
+SET secret = md5(random()::text || id) || md5(random()::text || created_at::text);
+
+ALTER TABLE event_integrations
+    ALTER COLUMN secret SET NOT NULL;
+
+ALTER TABLE event_integrations DROP CONSTRAINT event_integrations_provider_external_event_id_key;
+
+CREATE UNIQUE INDEX idx_event_integrations_event_external_event ON event_integrations(event_id, provider, external_event_id);
@@ -0,0 +1,17 @@
+-- A registrant holds at most one active ticket per event, so that concurrent requests cannot both issue
+-- one. Duplicates are revoked, keeping the latest ticket.
+UPDATE issued_tickets t
+SET revoked_at = NOW(),
+    revocation_reason = 'duplicate ticket'
+WHERE t.revoked_at IS NULL
+    AND EXISTS (
+        SELECT 1
+        FROM issued_tickets newer
+        WHERE newer.event_id = t.event_id
+            AND newer.email = t.email
+            AND newer.revoked_at IS NULL
+            AND (newer.issued_at, newer.credential_id) > (t.issued_at, t.credential_id)
+    );
+
+CREATE UNIQUE INDEX idx_issued_tickets_active_event_id_email ON issued_tickets(event_id, email)
+WHERE revoked_at IS NULL;
@@ -0,0 +1,11 @@
+-- Results of the scans uploaded by offline scanners, by the nonce the device gave each scan, so that a
+-- retried upload gets the original result of the scans that were already recorded
+CREATE TABLE offline_scans (
+    scanner_id VARCHAR NOT NULL REFERENCES scanners(id) ON DELETE CASCADE,
+    nonce VARCHAR NOT NULL,
+    event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    nullifier VARCHAR NOT NULL,
+    status VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (scanner_id, nonce)
+);
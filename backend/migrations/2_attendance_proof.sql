@@ -0,0 +1 @@
+ALTER TABLE events ADD COLUMN verification_key VARCHAR NOT NULL DEFAULT '';
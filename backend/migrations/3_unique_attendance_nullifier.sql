@@ -0,0 +1,26 @@
+ALTER TABLE events ADD COLUMN allow_reentry BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE attendances ADD COLUMN scan_count INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE attendances ADD COLUMN last_scanned_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Fold duplicate check-ins into the earliest row before enforcing uniqueness
+UPDATE attendances a
+SET scan_count = d.scan_count,
+    last_scanned_at = d.last_scanned_at
+FROM (
+        SELECT MIN(id) AS id,
+            COUNT(*) AS scan_count,
+            MAX(created_at) AS last_scanned_at
+        FROM attendances
+        GROUP BY event_id,
+            nullifier
+    ) d
+WHERE a.id = d.id;
+
+DELETE FROM attendances a USING attendances b
+WHERE a.event_id = b.event_id
+    AND a.nullifier = b.nullifier
+    AND a.id > b.id;
+
+DROP INDEX idx_event_id_nullifier;
+CREATE UNIQUE INDEX idx_event_id_nullifier ON attendances(event_id, nullifier);
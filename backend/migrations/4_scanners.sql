@@ -0,0 +1,11 @@
+CREATE TABLE scanners (
+    id VARCHAR PRIMARY KEY,
+    event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    name VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_scanners_event_id ON scanners(event_id);
+
+ALTER TABLE attendances ADD COLUMN scanner_id VARCHAR REFERENCES scanners(id);
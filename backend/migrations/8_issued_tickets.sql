@@ -0,0 +1,13 @@
+CREATE TABLE issued_tickets (
+    credential_id VARCHAR PRIMARY KEY,
+    event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    email VARCHAR NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    revoked_at TIMESTAMPTZ,
+    revocation_reason VARCHAR NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_issued_tickets_event_id_email ON issued_tickets(event_id, email);
+
+CREATE INDEX idx_issued_tickets_revoked ON issued_tickets(event_id, revoked_at)
+WHERE revoked_at IS NOT NULL;
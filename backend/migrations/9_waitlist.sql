@@ -0,0 +1,9 @@
+ALTER TABLE events ADD COLUMN capacity INTEGER CHECK (capacity > 0);
+
+CREATE TABLE waitlist (
+    id SERIAL PRIMARY KEY,
+    event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    email VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE(event_id, email)
+);
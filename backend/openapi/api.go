@@ -21,16 +21,50 @@ import (
 // The DefaultAPIRouter implementation should parse necessary information from the http request,
 // pass the data to a DefaultAPIServicer to perform the required actions, then write the service results to the http response.
 type DefaultAPIRouter interface { 
+	EventsEventIdAttendanceBatchPost(http.ResponseWriter, *http.Request)
 	EventsEventIdAttendancePost(http.ResponseWriter, *http.Request)
+	EventsEventIdAttendanceStreamTicketPost(http.ResponseWriter, *http.Request)
+	EventsEventIdCredentialsPost(http.ResponseWriter, *http.Request)
+	EventsEventIdDelete(http.ResponseWriter, *http.Request)
 	EventsEventIdGet(http.ResponseWriter, *http.Request)
+	EventsEventIdIntegrationsGet(http.ResponseWriter, *http.Request)
+	EventsEventIdIntegrationsPost(http.ResponseWriter, *http.Request)
+	EventsEventIdManifestGet(http.ResponseWriter, *http.Request)
+	EventsEventIdPut(http.ResponseWriter, *http.Request)
+	EventsEventIdRegisterPost(http.ResponseWriter, *http.Request)
+	EventsEventIdRegistrationDelete(http.ResponseWriter, *http.Request)
+	EventsEventIdRegistrationsEmailDelete(http.ResponseWriter, *http.Request)
+	EventsEventIdRegistrationsEmailPut(http.ResponseWriter, *http.Request)
+	EventsEventIdRegistrationsImportPost(http.ResponseWriter, *http.Request)
 	EventsEventIdRequestTicketCredentialPost(http.ResponseWriter, *http.Request)
+	EventsEventIdRevocationsGet(http.ResponseWriter, *http.Request)
+	EventsEventIdRevocationsPost(http.ResponseWriter, *http.Request)
+	EventsEventIdScannersPost(http.ResponseWriter, *http.Request)
+	EventsEventIdScannersScannerIdRevokePost(http.ResponseWriter, *http.Request)
+	EventsEventIdStatsGet(http.ResponseWriter, *http.Request)
+	EventsEventIdTicketIssuanceJobIdGet(http.ResponseWriter, *http.Request)
+	EventsEventIdTicketIssuancePost(http.ResponseWriter, *http.Request)
+	EventsEventIdWaitlistDelete(http.ResponseWriter, *http.Request)
+	EventsEventIdWaitlistGet(http.ResponseWriter, *http.Request)
+	EventsEventIdWaitlistPost(http.ResponseWriter, *http.Request)
 	EventsGet(http.ResponseWriter, *http.Request)
+	EventsPost(http.ResponseWriter, *http.Request)
 	HealthGet(http.ResponseWriter, *http.Request)
+	OrganizationsGet(http.ResponseWriter, *http.Request)
+	OrganizationsOrganizationIdCredentialTypesGet(http.ResponseWriter, *http.Request)
+	OrganizationsOrganizationIdCredentialTypesPost(http.ResponseWriter, *http.Request)
+	OrganizationsOrganizationIdEventsGet(http.ResponseWriter, *http.Request)
+	OrganizationsOrganizationIdMembersGet(http.ResponseWriter, *http.Request)
+	OrganizationsOrganizationIdMembersPut(http.ResponseWriter, *http.Request)
+	OrganizationsOrganizationIdMembersUserIdDelete(http.ResponseWriter, *http.Request)
+	OrganizationsPost(http.ResponseWriter, *http.Request)
 	UserLoginPost(http.ResponseWriter, *http.Request)
+	UserMeCredentialsGet(http.ResponseWriter, *http.Request)
 	UserMeEmailCredentialGet(http.ResponseWriter, *http.Request)
 	UserMeEmailCredentialPut(http.ResponseWriter, *http.Request)
 	UserMeGet(http.ResponseWriter, *http.Request)
 	UserMeRequestEmailCredentialPost(http.ResponseWriter, *http.Request)
+	UserMeRequestEmailDomainCredentialPost(http.ResponseWriter, *http.Request)
 	UserMeTicketCredentialPut(http.ResponseWriter, *http.Request)
 	UserMeTicketCredentialsGet(http.ResponseWriter, *http.Request)
 	UserRequestVerificationCodePost(http.ResponseWriter, *http.Request)
@@ -43,16 +77,50 @@ type DefaultAPIRouter interface {
 // while the service implementation can be ignored with the .openapi-generator-ignore file
 // and updated with the logic required for the API.
 type DefaultAPIServicer interface { 
+	EventsEventIdAttendanceBatchPost(context.Context, string, BatchAttendanceRequest) (ImplResponse, error)
 	EventsEventIdAttendancePost(context.Context, string, RecordAttendanceRequest) (ImplResponse, error)
+	EventsEventIdAttendanceStreamTicketPost(context.Context, string) (ImplResponse, error)
+	EventsEventIdCredentialsPost(context.Context, string, CredentialIssuanceRequest) (ImplResponse, error)
+	EventsEventIdDelete(context.Context, string) (ImplResponse, error)
 	EventsEventIdGet(context.Context, string) (ImplResponse, error)
+	EventsEventIdIntegrationsGet(context.Context, string) (ImplResponse, error)
+	EventsEventIdIntegrationsPost(context.Context, string, EventIntegrationInput) (ImplResponse, error)
+	EventsEventIdManifestGet(context.Context, string, string) (ImplResponse, error)
+	EventsEventIdPut(context.Context, string, EventInput) (ImplResponse, error)
+	EventsEventIdRegisterPost(context.Context, string) (ImplResponse, error)
+	EventsEventIdRegistrationDelete(context.Context, string) (ImplResponse, error)
+	EventsEventIdRegistrationsEmailDelete(context.Context, string, string) (ImplResponse, error)
+	EventsEventIdRegistrationsEmailPut(context.Context, string, string, RegistrationInput) (ImplResponse, error)
+	EventsEventIdRegistrationsImportPost(context.Context, string, RegistrationImportRequest) (ImplResponse, error)
 	EventsEventIdRequestTicketCredentialPost(context.Context, string) (ImplResponse, error)
+	EventsEventIdRevocationsGet(context.Context, string) (ImplResponse, error)
+	EventsEventIdRevocationsPost(context.Context, string, TicketRevocationRequest) (ImplResponse, error)
+	EventsEventIdScannersPost(context.Context, string, RegisterScannerRequest) (ImplResponse, error)
+	EventsEventIdScannersScannerIdRevokePost(context.Context, string, string) (ImplResponse, error)
+	EventsEventIdStatsGet(context.Context, string) (ImplResponse, error)
+	EventsEventIdTicketIssuanceJobIdGet(context.Context, string, string) (ImplResponse, error)
+	EventsEventIdTicketIssuancePost(context.Context, string) (ImplResponse, error)
+	EventsEventIdWaitlistDelete(context.Context, string) (ImplResponse, error)
+	EventsEventIdWaitlistGet(context.Context, string) (ImplResponse, error)
+	EventsEventIdWaitlistPost(context.Context, string) (ImplResponse, error)
 	EventsGet(context.Context) (ImplResponse, error)
+	EventsPost(context.Context, EventInput) (ImplResponse, error)
 	HealthGet(context.Context) (ImplResponse, error)
+	OrganizationsGet(context.Context) (ImplResponse, error)
+	OrganizationsOrganizationIdCredentialTypesGet(context.Context, string) (ImplResponse, error)
+	OrganizationsOrganizationIdCredentialTypesPost(context.Context, string, CredentialTypeInput) (ImplResponse, error)
+	OrganizationsOrganizationIdEventsGet(context.Context, string) (ImplResponse, error)
+	OrganizationsOrganizationIdMembersGet(context.Context, string) (ImplResponse, error)
+	OrganizationsOrganizationIdMembersPut(context.Context, string, OrganizationMemberInput) (ImplResponse, error)
+	OrganizationsOrganizationIdMembersUserIdDelete(context.Context, string, string) (ImplResponse, error)
+	OrganizationsPost(context.Context, OrganizationInput) (ImplResponse, error)
 	UserLoginPost(context.Context, UserLogin) (ImplResponse, error)
+	UserMeCredentialsGet(context.Context) (ImplResponse, error)
 	UserMeEmailCredentialGet(context.Context) (ImplResponse, error)
 	UserMeEmailCredentialPut(context.Context, PutEmailCredentialRequest) (ImplResponse, error)
 	UserMeGet(context.Context) (ImplResponse, error)
 	UserMeRequestEmailCredentialPost(context.Context) (ImplResponse, error)
+	UserMeRequestEmailDomainCredentialPost(context.Context) (ImplResponse, error)
 	UserMeTicketCredentialPut(context.Context, PutTicketCredentialRequest) (ImplResponse, error)
 	UserMeTicketCredentialsGet(context.Context) (ImplResponse, error)
 	UserRequestVerificationCodePost(context.Context, UserEmailVerificationRequest) (ImplResponse, error)
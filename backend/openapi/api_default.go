@@ -51,36 +51,201 @@ func NewDefaultAPIController(s DefaultAPIServicer, opts ...DefaultAPIOption) *De
 // Routes returns all the api routes for the DefaultAPIController
 func (c *DefaultAPIController) Routes() Routes {
 	return Routes{
+		"EventsEventIdAttendanceBatchPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/attendance/batch",
+			c.EventsEventIdAttendanceBatchPost,
+		},
 		"EventsEventIdAttendancePost": Route{
 			strings.ToUpper("Post"),
 			"/v1/events/{eventId}/attendance",
 			c.EventsEventIdAttendancePost,
 		},
+		"EventsEventIdAttendanceStreamTicketPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/attendance/stream-ticket",
+			c.EventsEventIdAttendanceStreamTicketPost,
+		},
+		"EventsEventIdCredentialsPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/credentials",
+			c.EventsEventIdCredentialsPost,
+		},
+		"EventsEventIdDelete": Route{
+			strings.ToUpper("Delete"),
+			"/v1/events/{eventId}",
+			c.EventsEventIdDelete,
+		},
 		"EventsEventIdGet": Route{
 			strings.ToUpper("Get"),
 			"/v1/events/{eventId}",
 			c.EventsEventIdGet,
 		},
+		"EventsEventIdIntegrationsGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/events/{eventId}/integrations",
+			c.EventsEventIdIntegrationsGet,
+		},
+		"EventsEventIdIntegrationsPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/integrations",
+			c.EventsEventIdIntegrationsPost,
+		},
+		"EventsEventIdManifestGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/events/{eventId}/manifest",
+			c.EventsEventIdManifestGet,
+		},
+		"EventsEventIdPut": Route{
+			strings.ToUpper("Put"),
+			"/v1/events/{eventId}",
+			c.EventsEventIdPut,
+		},
+		"EventsEventIdRegisterPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/register",
+			c.EventsEventIdRegisterPost,
+		},
+		"EventsEventIdRegistrationDelete": Route{
+			strings.ToUpper("Delete"),
+			"/v1/events/{eventId}/registration",
+			c.EventsEventIdRegistrationDelete,
+		},
+		"EventsEventIdRegistrationsEmailDelete": Route{
+			strings.ToUpper("Delete"),
+			"/v1/events/{eventId}/registrations/{email}",
+			c.EventsEventIdRegistrationsEmailDelete,
+		},
+		"EventsEventIdRegistrationsEmailPut": Route{
+			strings.ToUpper("Put"),
+			"/v1/events/{eventId}/registrations/{email}",
+			c.EventsEventIdRegistrationsEmailPut,
+		},
+		"EventsEventIdRegistrationsImportPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/registrations/import",
+			c.EventsEventIdRegistrationsImportPost,
+		},
 		"EventsEventIdRequestTicketCredentialPost": Route{
 			strings.ToUpper("Post"),
 			"/v1/events/{eventId}/request-ticket-credential",
 			c.EventsEventIdRequestTicketCredentialPost,
 		},
+		"EventsEventIdRevocationsGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/events/{eventId}/revocations",
+			c.EventsEventIdRevocationsGet,
+		},
+		"EventsEventIdRevocationsPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/revocations",
+			c.EventsEventIdRevocationsPost,
+		},
+		"EventsEventIdScannersPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/scanners",
+			c.EventsEventIdScannersPost,
+		},
+		"EventsEventIdScannersScannerIdRevokePost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/scanners/{scannerId}/revoke",
+			c.EventsEventIdScannersScannerIdRevokePost,
+		},
+		"EventsEventIdStatsGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/events/{eventId}/stats",
+			c.EventsEventIdStatsGet,
+		},
+		"EventsEventIdTicketIssuanceJobIdGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/events/{eventId}/ticket-issuance/{jobId}",
+			c.EventsEventIdTicketIssuanceJobIdGet,
+		},
+		"EventsEventIdTicketIssuancePost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/ticket-issuance",
+			c.EventsEventIdTicketIssuancePost,
+		},
+		"EventsEventIdWaitlistDelete": Route{
+			strings.ToUpper("Delete"),
+			"/v1/events/{eventId}/waitlist",
+			c.EventsEventIdWaitlistDelete,
+		},
+		"EventsEventIdWaitlistGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/events/{eventId}/waitlist",
+			c.EventsEventIdWaitlistGet,
+		},
+		"EventsEventIdWaitlistPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events/{eventId}/waitlist",
+			c.EventsEventIdWaitlistPost,
+		},
 		"EventsGet": Route{
 			strings.ToUpper("Get"),
 			"/v1/events",
 			c.EventsGet,
 		},
+		"EventsPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/events",
+			c.EventsPost,
+		},
 		"HealthGet": Route{
 			strings.ToUpper("Get"),
 			"/v1/health",
 			c.HealthGet,
 		},
+		"OrganizationsGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/organizations",
+			c.OrganizationsGet,
+		},
+		"OrganizationsOrganizationIdCredentialTypesGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/organizations/{organizationId}/credential-types",
+			c.OrganizationsOrganizationIdCredentialTypesGet,
+		},
+		"OrganizationsOrganizationIdCredentialTypesPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/organizations/{organizationId}/credential-types",
+			c.OrganizationsOrganizationIdCredentialTypesPost,
+		},
+		"OrganizationsOrganizationIdEventsGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/organizations/{organizationId}/events",
+			c.OrganizationsOrganizationIdEventsGet,
+		},
+		"OrganizationsOrganizationIdMembersGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/organizations/{organizationId}/members",
+			c.OrganizationsOrganizationIdMembersGet,
+		},
+		"OrganizationsOrganizationIdMembersPut": Route{
+			strings.ToUpper("Put"),
+			"/v1/organizations/{organizationId}/members",
+			c.OrganizationsOrganizationIdMembersPut,
+		},
+		"OrganizationsOrganizationIdMembersUserIdDelete": Route{
+			strings.ToUpper("Delete"),
+			"/v1/organizations/{organizationId}/members/{userId}",
+			c.OrganizationsOrganizationIdMembersUserIdDelete,
+		},
+		"OrganizationsPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/organizations",
+			c.OrganizationsPost,
+		},
 		"UserLoginPost": Route{
 			strings.ToUpper("Post"),
 			"/v1/user/login",
 			c.UserLoginPost,
 		},
+		"UserMeCredentialsGet": Route{
+			strings.ToUpper("Get"),
+			"/v1/user/me/credentials",
+			c.UserMeCredentialsGet,
+		},
 		"UserMeEmailCredentialGet": Route{
 			strings.ToUpper("Get"),
 			"/v1/user/me/email-credential",
@@ -101,6 +266,11 @@ func (c *DefaultAPIController) Routes() Routes {
 			"/v1/user/me/request-email-credential",
 			c.UserMeRequestEmailCredentialPost,
 		},
+		"UserMeRequestEmailDomainCredentialPost": Route{
+			strings.ToUpper("Post"),
+			"/v1/user/me/request-email-domain-credential",
+			c.UserMeRequestEmailDomainCredentialPost,
+		},
 		"UserMeTicketCredentialPut": Route{
 			strings.ToUpper("Put"),
 			"/v1/user/me/ticket-credential",
@@ -124,6 +294,39 @@ func (c *DefaultAPIController) Routes() Routes {
 	}
 }
 
+// EventsEventIdAttendanceBatchPost - Upload attendance recorded while offline
+func (c *DefaultAPIController) EventsEventIdAttendanceBatchPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	batchAttendanceRequestParam := BatchAttendanceRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&batchAttendanceRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertBatchAttendanceRequestRequired(batchAttendanceRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertBatchAttendanceRequestConstraints(batchAttendanceRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdAttendanceBatchPost(r.Context(), eventIdParam, batchAttendanceRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
 // EventsEventIdAttendancePost - Record attendance for an event
 func (c *DefaultAPIController) EventsEventIdAttendancePost(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
@@ -157,15 +360,15 @@ func (c *DefaultAPIController) EventsEventIdAttendancePost(w http.ResponseWriter
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// EventsEventIdGet - Get event details
-func (c *DefaultAPIController) EventsEventIdGet(w http.ResponseWriter, r *http.Request) {
+// EventsEventIdAttendanceStreamTicketPost - Get a single use ticket to open the live attendance stream of an event
+func (c *DefaultAPIController) EventsEventIdAttendanceStreamTicketPost(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
 	eventIdParam := params["eventId"]
 	if eventIdParam == "" {
 		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
 		return
 	}
-	result, err := c.service.EventsEventIdGet(r.Context(), eventIdParam)
+	result, err := c.service.EventsEventIdAttendanceStreamTicketPost(r.Context(), eventIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -175,15 +378,30 @@ func (c *DefaultAPIController) EventsEventIdGet(w http.ResponseWriter, r *http.R
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// EventsEventIdRequestTicketCredentialPost - Request a new ticket credential for an event
-func (c *DefaultAPIController) EventsEventIdRequestTicketCredentialPost(w http.ResponseWriter, r *http.Request) {
+// EventsEventIdCredentialsPost - Issue credentials of a type to registrants of the event, such as speaker or volunteer credentials
+func (c *DefaultAPIController) EventsEventIdCredentialsPost(w http.ResponseWriter, r *http.Request) {
 	params := mux.Vars(r)
 	eventIdParam := params["eventId"]
 	if eventIdParam == "" {
 		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
 		return
 	}
-	result, err := c.service.EventsEventIdRequestTicketCredentialPost(r.Context(), eventIdParam)
+	credentialIssuanceRequestParam := CredentialIssuanceRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&credentialIssuanceRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCredentialIssuanceRequestRequired(credentialIssuanceRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCredentialIssuanceRequestConstraints(credentialIssuanceRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdCredentialsPost(r.Context(), eventIdParam, credentialIssuanceRequestParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -193,9 +411,15 @@ func (c *DefaultAPIController) EventsEventIdRequestTicketCredentialPost(w http.R
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// EventsGet - Get list of events
-func (c *DefaultAPIController) EventsGet(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.EventsGet(r.Context())
+// EventsEventIdDelete - Delete an event with its registrations, credentials and attendance
+func (c *DefaultAPIController) EventsEventIdDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdDelete(r.Context(), eventIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -205,9 +429,15 @@ func (c *DefaultAPIController) EventsGet(w http.ResponseWriter, r *http.Request)
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// HealthGet - Check the health of the API
-func (c *DefaultAPIController) HealthGet(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.HealthGet(r.Context())
+// EventsEventIdGet - Get event details
+func (c *DefaultAPIController) EventsEventIdGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdGet(r.Context(), eventIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -217,24 +447,48 @@ func (c *DefaultAPIController) HealthGet(w http.ResponseWriter, r *http.Request)
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// UserLoginPost - User login
-func (c *DefaultAPIController) UserLoginPost(w http.ResponseWriter, r *http.Request) {
-	userLoginParam := UserLogin{}
+// EventsEventIdIntegrationsGet - List the ticketing platform events linked to an event
+func (c *DefaultAPIController) EventsEventIdIntegrationsGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdIntegrationsGet(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdIntegrationsPost - Link an event on a ticketing platform, so that its order webhooks register attendees
+func (c *DefaultAPIController) EventsEventIdIntegrationsPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	eventIntegrationInputParam := EventIntegrationInput{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
-	if err := d.Decode(&userLoginParam); err != nil {
+	if err := d.Decode(&eventIntegrationInputParam); err != nil {
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	if err := AssertUserLoginRequired(userLoginParam); err != nil {
+	if err := AssertEventIntegrationInputRequired(eventIntegrationInputParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	if err := AssertUserLoginConstraints(userLoginParam); err != nil {
+	if err := AssertEventIntegrationInputConstraints(eventIntegrationInputParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.UserLoginPost(r.Context(), userLoginParam)
+	result, err := c.service.EventsEventIdIntegrationsPost(r.Context(), eventIdParam, eventIntegrationInputParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -244,9 +498,27 @@ func (c *DefaultAPIController) UserLoginPost(w http.ResponseWriter, r *http.Requ
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// UserMeEmailCredentialGet - Get user email credential
-func (c *DefaultAPIController) UserMeEmailCredentialGet(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.UserMeEmailCredentialGet(r.Context())
+// EventsEventIdManifestGet - Download the signed verification manifest for offline scanners
+func (c *DefaultAPIController) EventsEventIdManifestGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	query, err := parseQuery(r.URL.RawQuery)
+	if err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	var knownVersionParam string
+	if query.Has("known_version") {
+		param := query.Get("known_version")
+
+		knownVersionParam = param
+	} else {
+	}
+	result, err := c.service.EventsEventIdManifestGet(r.Context(), eventIdParam, knownVersionParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -256,24 +528,30 @@ func (c *DefaultAPIController) UserMeEmailCredentialGet(w http.ResponseWriter, r
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// UserMeEmailCredentialPut - Store user email credential with encrypted data
-func (c *DefaultAPIController) UserMeEmailCredentialPut(w http.ResponseWriter, r *http.Request) {
-	putEmailCredentialRequestParam := PutEmailCredentialRequest{}
+// EventsEventIdPut - Update an event
+func (c *DefaultAPIController) EventsEventIdPut(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	eventInputParam := EventInput{}
 	d := json.NewDecoder(r.Body)
 	d.DisallowUnknownFields()
-	if err := d.Decode(&putEmailCredentialRequestParam); err != nil {
+	if err := d.Decode(&eventInputParam); err != nil {
 		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
 		return
 	}
-	if err := AssertPutEmailCredentialRequestRequired(putEmailCredentialRequestParam); err != nil {
+	if err := AssertEventInputRequired(eventInputParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	if err := AssertPutEmailCredentialRequestConstraints(putEmailCredentialRequestParam); err != nil {
+	if err := AssertEventInputConstraints(eventInputParam); err != nil {
 		c.errorHandler(w, r, err, nil)
 		return
 	}
-	result, err := c.service.UserMeEmailCredentialPut(r.Context(), putEmailCredentialRequestParam)
+	result, err := c.service.EventsEventIdPut(r.Context(), eventIdParam, eventInputParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -283,9 +561,15 @@ func (c *DefaultAPIController) UserMeEmailCredentialPut(w http.ResponseWriter, r
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// UserMeGet - Get user details
-func (c *DefaultAPIController) UserMeGet(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.UserMeGet(r.Context())
+// EventsEventIdRegisterPost - Register the user for an open event, or for a domain event with an email of an allowed domain
+func (c *DefaultAPIController) EventsEventIdRegisterPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRegisterPost(r.Context(), eventIdParam)
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -295,9 +579,694 @@ func (c *DefaultAPIController) UserMeGet(w http.ResponseWriter, r *http.Request)
 	_ = EncodeJSONResponse(result.Body, &result.Code, w)
 }
 
-// UserMeRequestEmailCredentialPost - Generate a new email credential
-func (c *DefaultAPIController) UserMeRequestEmailCredentialPost(w http.ResponseWriter, r *http.Request) {
-	result, err := c.service.UserMeRequestEmailCredentialPost(r.Context())
+// EventsEventIdRegistrationDelete - Cancel the registration of the user, the ticket of the user is deleted and revoked
+func (c *DefaultAPIController) EventsEventIdRegistrationDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRegistrationDelete(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdRegistrationsEmailDelete - Cancel the registration of an attendee, the ticket of the attendee is deleted and revoked
+func (c *DefaultAPIController) EventsEventIdRegistrationsEmailDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	emailParam := params["email"]
+	if emailParam == "" {
+		c.errorHandler(w, r, &RequiredError{"email"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRegistrationsEmailDelete(r.Context(), eventIdParam, emailParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdRegistrationsEmailPut - Update the registration of an attendee, such as the ticket tier
+func (c *DefaultAPIController) EventsEventIdRegistrationsEmailPut(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	emailParam := params["email"]
+	if emailParam == "" {
+		c.errorHandler(w, r, &RequiredError{"email"}, nil)
+		return
+	}
+	registrationInputParam := RegistrationInput{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&registrationInputParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertRegistrationInputRequired(registrationInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertRegistrationInputConstraints(registrationInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRegistrationsEmailPut(r.Context(), eventIdParam, emailParam, registrationInputParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdRegistrationsImportPost - Import registrations from a CSV of emails
+func (c *DefaultAPIController) EventsEventIdRegistrationsImportPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	registrationImportRequestParam := RegistrationImportRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&registrationImportRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertRegistrationImportRequestRequired(registrationImportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertRegistrationImportRequestConstraints(registrationImportRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRegistrationsImportPost(r.Context(), eventIdParam, registrationImportRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdRequestTicketCredentialPost - Request a new ticket credential for an event
+func (c *DefaultAPIController) EventsEventIdRequestTicketCredentialPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRequestTicketCredentialPost(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdRevocationsGet - Get the revocation list of an event, scanners reject the tickets on it
+func (c *DefaultAPIController) EventsEventIdRevocationsGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRevocationsGet(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdRevocationsPost - Revoke the tickets issued to an email, for refunds and bans
+func (c *DefaultAPIController) EventsEventIdRevocationsPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	ticketRevocationRequestParam := TicketRevocationRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&ticketRevocationRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertTicketRevocationRequestRequired(ticketRevocationRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertTicketRevocationRequestConstraints(ticketRevocationRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdRevocationsPost(r.Context(), eventIdParam, ticketRevocationRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdScannersPost - Register a scanner device for an event
+func (c *DefaultAPIController) EventsEventIdScannersPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	registerScannerRequestParam := RegisterScannerRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&registerScannerRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertRegisterScannerRequestRequired(registerScannerRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertRegisterScannerRequestConstraints(registerScannerRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdScannersPost(r.Context(), eventIdParam, registerScannerRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdScannersScannerIdRevokePost - Revoke a scanner device
+func (c *DefaultAPIController) EventsEventIdScannersScannerIdRevokePost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	scannerIdParam := params["scannerId"]
+	if scannerIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"scannerId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdScannersScannerIdRevokePost(r.Context(), eventIdParam, scannerIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdStatsGet - Get registration, issuance and check-in statistics for an event
+func (c *DefaultAPIController) EventsEventIdStatsGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdStatsGet(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdTicketIssuanceJobIdGet - Get the progress of a ticket pre-issuance job
+func (c *DefaultAPIController) EventsEventIdTicketIssuanceJobIdGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	jobIdParam := params["jobId"]
+	if jobIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"jobId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdTicketIssuanceJobIdGet(r.Context(), eventIdParam, jobIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdTicketIssuancePost - Start a job that pre-issues tickets to every registrant with an identity commitment
+func (c *DefaultAPIController) EventsEventIdTicketIssuancePost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdTicketIssuancePost(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdWaitlistDelete - Leave the waitlist of an event
+func (c *DefaultAPIController) EventsEventIdWaitlistDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdWaitlistDelete(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdWaitlistGet - List the waitlist of an event in order
+func (c *DefaultAPIController) EventsEventIdWaitlistGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdWaitlistGet(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsEventIdWaitlistPost - Join the waitlist of a full event, the user is registered right away if there is a free place
+func (c *DefaultAPIController) EventsEventIdWaitlistPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	eventIdParam := params["eventId"]
+	if eventIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"eventId"}, nil)
+		return
+	}
+	result, err := c.service.EventsEventIdWaitlistPost(r.Context(), eventIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsGet - List published events
+func (c *DefaultAPIController) EventsGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.EventsGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// EventsPost - Create an event
+func (c *DefaultAPIController) EventsPost(w http.ResponseWriter, r *http.Request) {
+	eventInputParam := EventInput{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&eventInputParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertEventInputRequired(eventInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertEventInputConstraints(eventInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.EventsPost(r.Context(), eventInputParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// HealthGet - Check the health of the API
+func (c *DefaultAPIController) HealthGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.HealthGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsGet - List the organizations of the user
+func (c *DefaultAPIController) OrganizationsGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.OrganizationsGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsOrganizationIdCredentialTypesGet - List the credential types an organization can issue, the primitive types and the types it defined
+func (c *DefaultAPIController) OrganizationsOrganizationIdCredentialTypesGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	organizationIdParam := params["organizationId"]
+	if organizationIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"organizationId"}, nil)
+		return
+	}
+	result, err := c.service.OrganizationsOrganizationIdCredentialTypesGet(r.Context(), organizationIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsOrganizationIdCredentialTypesPost - Register a credential type of the organization, the type must already be registered with the type registry of the protocol
+func (c *DefaultAPIController) OrganizationsOrganizationIdCredentialTypesPost(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	organizationIdParam := params["organizationId"]
+	if organizationIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"organizationId"}, nil)
+		return
+	}
+	credentialTypeInputParam := CredentialTypeInput{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&credentialTypeInputParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertCredentialTypeInputRequired(credentialTypeInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertCredentialTypeInputConstraints(credentialTypeInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.OrganizationsOrganizationIdCredentialTypesPost(r.Context(), organizationIdParam, credentialTypeInputParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsOrganizationIdEventsGet - List every event of an organization, including drafts and scheduled events
+func (c *DefaultAPIController) OrganizationsOrganizationIdEventsGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	organizationIdParam := params["organizationId"]
+	if organizationIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"organizationId"}, nil)
+		return
+	}
+	result, err := c.service.OrganizationsOrganizationIdEventsGet(r.Context(), organizationIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsOrganizationIdMembersGet - List the members of an organization
+func (c *DefaultAPIController) OrganizationsOrganizationIdMembersGet(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	organizationIdParam := params["organizationId"]
+	if organizationIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"organizationId"}, nil)
+		return
+	}
+	result, err := c.service.OrganizationsOrganizationIdMembersGet(r.Context(), organizationIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsOrganizationIdMembersPut - Add a member to an organization or change the role of a member
+func (c *DefaultAPIController) OrganizationsOrganizationIdMembersPut(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	organizationIdParam := params["organizationId"]
+	if organizationIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"organizationId"}, nil)
+		return
+	}
+	organizationMemberInputParam := OrganizationMemberInput{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&organizationMemberInputParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertOrganizationMemberInputRequired(organizationMemberInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertOrganizationMemberInputConstraints(organizationMemberInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.OrganizationsOrganizationIdMembersPut(r.Context(), organizationIdParam, organizationMemberInputParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsOrganizationIdMembersUserIdDelete - Remove a member from an organization
+func (c *DefaultAPIController) OrganizationsOrganizationIdMembersUserIdDelete(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	organizationIdParam := params["organizationId"]
+	if organizationIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"organizationId"}, nil)
+		return
+	}
+	userIdParam := params["userId"]
+	if userIdParam == "" {
+		c.errorHandler(w, r, &RequiredError{"userId"}, nil)
+		return
+	}
+	result, err := c.service.OrganizationsOrganizationIdMembersUserIdDelete(r.Context(), organizationIdParam, userIdParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// OrganizationsPost - Create an organization, the user becomes its owner
+func (c *DefaultAPIController) OrganizationsPost(w http.ResponseWriter, r *http.Request) {
+	organizationInputParam := OrganizationInput{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&organizationInputParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertOrganizationInputRequired(organizationInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertOrganizationInputConstraints(organizationInputParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.OrganizationsPost(r.Context(), organizationInputParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UserLoginPost - User login
+func (c *DefaultAPIController) UserLoginPost(w http.ResponseWriter, r *http.Request) {
+	userLoginParam := UserLogin{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&userLoginParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertUserLoginRequired(userLoginParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertUserLoginConstraints(userLoginParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UserLoginPost(r.Context(), userLoginParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UserMeCredentialsGet - Get the credentials issued to the user by event organizers
+func (c *DefaultAPIController) UserMeCredentialsGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.UserMeCredentialsGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UserMeEmailCredentialGet - Get user email credential
+func (c *DefaultAPIController) UserMeEmailCredentialGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.UserMeEmailCredentialGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UserMeEmailCredentialPut - Store user email credential with encrypted data
+func (c *DefaultAPIController) UserMeEmailCredentialPut(w http.ResponseWriter, r *http.Request) {
+	putEmailCredentialRequestParam := PutEmailCredentialRequest{}
+	d := json.NewDecoder(r.Body)
+	d.DisallowUnknownFields()
+	if err := d.Decode(&putEmailCredentialRequestParam); err != nil {
+		c.errorHandler(w, r, &ParsingError{Err: err}, nil)
+		return
+	}
+	if err := AssertPutEmailCredentialRequestRequired(putEmailCredentialRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	if err := AssertPutEmailCredentialRequestConstraints(putEmailCredentialRequestParam); err != nil {
+		c.errorHandler(w, r, err, nil)
+		return
+	}
+	result, err := c.service.UserMeEmailCredentialPut(r.Context(), putEmailCredentialRequestParam)
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UserMeGet - Get user details
+func (c *DefaultAPIController) UserMeGet(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.UserMeGet(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UserMeRequestEmailCredentialPost - Generate a new email credential
+func (c *DefaultAPIController) UserMeRequestEmailCredentialPost(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.UserMeRequestEmailCredentialPost(r.Context())
+	// If an error occurred, encode the error with the status code
+	if err != nil {
+		c.errorHandler(w, r, err, &result)
+		return
+	}
+	// If no error, encode the body and the result code
+	_ = EncodeJSONResponse(result.Body, &result.Code, w)
+}
+
+// UserMeRequestEmailDomainCredentialPost - Generate a new email domain credential, with a hashed claim of the email domain so that membership of the domain can be proven without the address
+func (c *DefaultAPIController) UserMeRequestEmailDomainCredentialPost(w http.ResponseWriter, r *http.Request) {
+	result, err := c.service.UserMeRequestEmailDomainCredentialPost(r.Context())
 	// If an error occurred, encode the error with the status code
 	if err != nil {
 		c.errorHandler(w, r, err, &result)
@@ -27,17 +27,109 @@ func NewDefaultAPIService() *DefaultAPIService {
 	return &DefaultAPIService{}
 }
 
+// EventsEventIdAttendanceBatchPost - Upload attendance recorded while offline
+func (s *DefaultAPIService) EventsEventIdAttendanceBatchPost(ctx context.Context, eventId string, batchAttendanceRequest BatchAttendanceRequest) (ImplResponse, error) {
+	// TODO - update EventsEventIdAttendanceBatchPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, BatchAttendanceResponse{}) or use other options such as http.Ok ...
+	// return Response(200, BatchAttendanceResponse{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdAttendanceBatchPost method not implemented")
+}
+
 // EventsEventIdAttendancePost - Record attendance for an event
 func (s *DefaultAPIService) EventsEventIdAttendancePost(ctx context.Context, eventId string, recordAttendanceRequest RecordAttendanceRequest) (ImplResponse, error) {
 	// TODO - update EventsEventIdAttendancePost with the required logic for this service method.
 	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
 
-	// TODO: Uncomment the next line to return response Response(201, {}) or use other options such as http.Ok ...
-	// return Response(201, nil),nil
+	// TODO: Uncomment the next line to return response Response(201, Attendance{}) or use other options such as http.Ok ...
+	// return Response(201, Attendance{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(409, Attendance{}) or use other options such as http.Ok ...
+	// return Response(409, Attendance{}), nil
 
 	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdAttendancePost method not implemented")
 }
 
+// EventsEventIdAttendanceStreamTicketPost - Get a single use ticket to open the live attendance stream of an event
+func (s *DefaultAPIService) EventsEventIdAttendanceStreamTicketPost(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdAttendanceStreamTicketPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, AttendanceStreamTicket{}) or use other options such as http.Ok ...
+	// return Response(201, AttendanceStreamTicket{}), nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdAttendanceStreamTicketPost method not implemented")
+}
+
+// EventsEventIdCredentialsPost - Issue credentials of a type to registrants of the event, such as speaker or volunteer credentials
+func (s *DefaultAPIService) EventsEventIdCredentialsPost(ctx context.Context, eventId string, credentialIssuanceRequest CredentialIssuanceRequest) (ImplResponse, error) {
+	// TODO - update EventsEventIdCredentialsPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, CredentialIssuanceReport{}) or use other options such as http.Ok ...
+	// return Response(200, CredentialIssuanceReport{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdCredentialsPost method not implemented")
+}
+
+// EventsEventIdDelete - Delete an event with its registrations, credentials and attendance
+func (s *DefaultAPIService) EventsEventIdDelete(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdDelete with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(204, {}) or use other options such as http.Ok ...
+	// return Response(204, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdDelete method not implemented")
+}
+
 // EventsEventIdGet - Get event details
 func (s *DefaultAPIService) EventsEventIdGet(ctx context.Context, eventId string) (ImplResponse, error) {
 	// TODO - update EventsEventIdGet with the required logic for this service method.
@@ -46,9 +138,192 @@ func (s *DefaultAPIService) EventsEventIdGet(ctx context.Context, eventId string
 	// TODO: Uncomment the next line to return response Response(200, Event{}) or use other options such as http.Ok ...
 	// return Response(200, Event{}), nil
 
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdGet method not implemented")
 }
 
+// EventsEventIdIntegrationsGet - List the ticketing platform events linked to an event
+func (s *DefaultAPIService) EventsEventIdIntegrationsGet(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdIntegrationsGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []EventIntegration{}) or use other options such as http.Ok ...
+	// return Response(200, []EventIntegration{}), nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdIntegrationsGet method not implemented")
+}
+
+// EventsEventIdIntegrationsPost - Link an event on a ticketing platform, so that its order webhooks register attendees
+func (s *DefaultAPIService) EventsEventIdIntegrationsPost(ctx context.Context, eventId string, eventIntegrationInput EventIntegrationInput) (ImplResponse, error) {
+	// TODO - update EventsEventIdIntegrationsPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, EventIntegration{}) or use other options such as http.Ok ...
+	// return Response(201, EventIntegration{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(409, {}) or use other options such as http.Ok ...
+	// return Response(409, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdIntegrationsPost method not implemented")
+}
+
+// EventsEventIdManifestGet - Download the signed verification manifest for offline scanners
+func (s *DefaultAPIService) EventsEventIdManifestGet(ctx context.Context, eventId string, knownVersion string) (ImplResponse, error) {
+	// TODO - update EventsEventIdManifestGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, EventManifest{}) or use other options such as http.Ok ...
+	// return Response(200, EventManifest{}), nil
+
+	// TODO: Uncomment the next line to return response Response(304, {}) or use other options such as http.Ok ...
+	// return Response(304, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdManifestGet method not implemented")
+}
+
+// EventsEventIdPut - Update an event
+func (s *DefaultAPIService) EventsEventIdPut(ctx context.Context, eventId string, eventInput EventInput) (ImplResponse, error) {
+	// TODO - update EventsEventIdPut with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, Event{}) or use other options such as http.Ok ...
+	// return Response(200, Event{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdPut method not implemented")
+}
+
+// EventsEventIdRegisterPost - Register the user for an open event, or for a domain event with an email of an allowed domain
+func (s *DefaultAPIService) EventsEventIdRegisterPost(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdRegisterPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, WaitlistStatus{}) or use other options such as http.Ok ...
+	// return Response(200, WaitlistStatus{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRegisterPost method not implemented")
+}
+
+// EventsEventIdRegistrationDelete - Cancel the registration of the user, the ticket of the user is deleted and revoked
+func (s *DefaultAPIService) EventsEventIdRegistrationDelete(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdRegistrationDelete with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(204, {}) or use other options such as http.Ok ...
+	// return Response(204, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRegistrationDelete method not implemented")
+}
+
+// EventsEventIdRegistrationsEmailDelete - Cancel the registration of an attendee, the ticket of the attendee is deleted and revoked
+func (s *DefaultAPIService) EventsEventIdRegistrationsEmailDelete(ctx context.Context, eventId string, email string) (ImplResponse, error) {
+	// TODO - update EventsEventIdRegistrationsEmailDelete with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(204, {}) or use other options such as http.Ok ...
+	// return Response(204, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRegistrationsEmailDelete method not implemented")
+}
+
+// EventsEventIdRegistrationsEmailPut - Update the registration of an attendee, such as the ticket tier
+func (s *DefaultAPIService) EventsEventIdRegistrationsEmailPut(ctx context.Context, eventId string, email string, registrationInput RegistrationInput) (ImplResponse, error) {
+	// TODO - update EventsEventIdRegistrationsEmailPut with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, Registration{}) or use other options such as http.Ok ...
+	// return Response(200, Registration{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRegistrationsEmailPut method not implemented")
+}
+
+// EventsEventIdRegistrationsImportPost - Import registrations from a CSV of emails
+func (s *DefaultAPIService) EventsEventIdRegistrationsImportPost(ctx context.Context, eventId string, registrationImportRequest RegistrationImportRequest) (ImplResponse, error) {
+	// TODO - update EventsEventIdRegistrationsImportPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, RegistrationImportReport{}) or use other options such as http.Ok ...
+	// return Response(200, RegistrationImportReport{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRegistrationsImportPost method not implemented")
+}
+
 // EventsEventIdRequestTicketCredentialPost - Request a new ticket credential for an event
 func (s *DefaultAPIService) EventsEventIdRequestTicketCredentialPost(ctx context.Context, eventId string) (ImplResponse, error) {
 	// TODO - update EventsEventIdRequestTicketCredentialPost with the required logic for this service method.
@@ -57,10 +332,207 @@ func (s *DefaultAPIService) EventsEventIdRequestTicketCredentialPost(ctx context
 	// TODO: Uncomment the next line to return response Response(201, UnencryptedTicketCredential{}) or use other options such as http.Ok ...
 	// return Response(201, UnencryptedTicketCredential{}), nil
 
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
 	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRequestTicketCredentialPost method not implemented")
 }
 
-// EventsGet - Get list of events
+// EventsEventIdRevocationsGet - Get the revocation list of an event, scanners reject the tickets on it
+func (s *DefaultAPIService) EventsEventIdRevocationsGet(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdRevocationsGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []RevokedTicket{}) or use other options such as http.Ok ...
+	// return Response(200, []RevokedTicket{}), nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRevocationsGet method not implemented")
+}
+
+// EventsEventIdRevocationsPost - Revoke the tickets issued to an email, for refunds and bans
+func (s *DefaultAPIService) EventsEventIdRevocationsPost(ctx context.Context, eventId string, ticketRevocationRequest TicketRevocationRequest) (ImplResponse, error) {
+	// TODO - update EventsEventIdRevocationsPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []RevokedTicket{}) or use other options such as http.Ok ...
+	// return Response(200, []RevokedTicket{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdRevocationsPost method not implemented")
+}
+
+// EventsEventIdScannersPost - Register a scanner device for an event
+func (s *DefaultAPIService) EventsEventIdScannersPost(ctx context.Context, eventId string, registerScannerRequest RegisterScannerRequest) (ImplResponse, error) {
+	// TODO - update EventsEventIdScannersPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, ScannerCredential{}) or use other options such as http.Ok ...
+	// return Response(201, ScannerCredential{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdScannersPost method not implemented")
+}
+
+// EventsEventIdScannersScannerIdRevokePost - Revoke a scanner device
+func (s *DefaultAPIService) EventsEventIdScannersScannerIdRevokePost(ctx context.Context, eventId string, scannerId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdScannersScannerIdRevokePost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, Scanner{}) or use other options such as http.Ok ...
+	// return Response(200, Scanner{}), nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdScannersScannerIdRevokePost method not implemented")
+}
+
+// EventsEventIdStatsGet - Get registration, issuance and check-in statistics for an event
+func (s *DefaultAPIService) EventsEventIdStatsGet(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdStatsGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, EventStats{}) or use other options such as http.Ok ...
+	// return Response(200, EventStats{}), nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdStatsGet method not implemented")
+}
+
+// EventsEventIdTicketIssuanceJobIdGet - Get the progress of a ticket pre-issuance job
+func (s *DefaultAPIService) EventsEventIdTicketIssuanceJobIdGet(ctx context.Context, eventId string, jobId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdTicketIssuanceJobIdGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, TicketIssuanceJob{}) or use other options such as http.Ok ...
+	// return Response(200, TicketIssuanceJob{}), nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdTicketIssuanceJobIdGet method not implemented")
+}
+
+// EventsEventIdTicketIssuancePost - Start a job that pre-issues tickets to every registrant with an identity commitment
+func (s *DefaultAPIService) EventsEventIdTicketIssuancePost(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdTicketIssuancePost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(202, TicketIssuanceJob{}) or use other options such as http.Ok ...
+	// return Response(202, TicketIssuanceJob{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(409, {}) or use other options such as http.Ok ...
+	// return Response(409, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdTicketIssuancePost method not implemented")
+}
+
+// EventsEventIdWaitlistDelete - Leave the waitlist of an event
+func (s *DefaultAPIService) EventsEventIdWaitlistDelete(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdWaitlistDelete with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(204, {}) or use other options such as http.Ok ...
+	// return Response(204, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdWaitlistDelete method not implemented")
+}
+
+// EventsEventIdWaitlistGet - List the waitlist of an event in order
+func (s *DefaultAPIService) EventsEventIdWaitlistGet(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdWaitlistGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []WaitlistEntry{}) or use other options such as http.Ok ...
+	// return Response(200, []WaitlistEntry{}), nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdWaitlistGet method not implemented")
+}
+
+// EventsEventIdWaitlistPost - Join the waitlist of a full event, the user is registered right away if there is a free place
+func (s *DefaultAPIService) EventsEventIdWaitlistPost(ctx context.Context, eventId string) (ImplResponse, error) {
+	// TODO - update EventsEventIdWaitlistPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, WaitlistStatus{}) or use other options such as http.Ok ...
+	// return Response(200, WaitlistStatus{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(409, {}) or use other options such as http.Ok ...
+	// return Response(409, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsEventIdWaitlistPost method not implemented")
+}
+
+// EventsGet - List published events
 func (s *DefaultAPIService) EventsGet(ctx context.Context) (ImplResponse, error) {
 	// TODO - update EventsGet with the required logic for this service method.
 	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
@@ -71,6 +543,26 @@ func (s *DefaultAPIService) EventsGet(ctx context.Context) (ImplResponse, error)
 	return Response(http.StatusNotImplemented, nil), errors.New("EventsGet method not implemented")
 }
 
+// EventsPost - Create an event
+func (s *DefaultAPIService) EventsPost(ctx context.Context, eventInput EventInput) (ImplResponse, error) {
+	// TODO - update EventsPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, Event{}) or use other options such as http.Ok ...
+	// return Response(201, Event{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(401, {}) or use other options such as http.Ok ...
+	// return Response(401, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("EventsPost method not implemented")
+}
+
 // HealthGet - Check the health of the API
 func (s *DefaultAPIService) HealthGet(ctx context.Context) (ImplResponse, error) {
 	// TODO - update HealthGet with the required logic for this service method.
@@ -82,6 +574,133 @@ func (s *DefaultAPIService) HealthGet(ctx context.Context) (ImplResponse, error)
 	return Response(http.StatusNotImplemented, nil), errors.New("HealthGet method not implemented")
 }
 
+// OrganizationsGet - List the organizations of the user
+func (s *DefaultAPIService) OrganizationsGet(ctx context.Context) (ImplResponse, error) {
+	// TODO - update OrganizationsGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []Organization{}) or use other options such as http.Ok ...
+	// return Response(200, []Organization{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsGet method not implemented")
+}
+
+// OrganizationsOrganizationIdCredentialTypesGet - List the credential types an organization can issue, the primitive types and the types it defined
+func (s *DefaultAPIService) OrganizationsOrganizationIdCredentialTypesGet(ctx context.Context, organizationId string) (ImplResponse, error) {
+	// TODO - update OrganizationsOrganizationIdCredentialTypesGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []CredentialType{}) or use other options such as http.Ok ...
+	// return Response(200, []CredentialType{}), nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsOrganizationIdCredentialTypesGet method not implemented")
+}
+
+// OrganizationsOrganizationIdCredentialTypesPost - Register a credential type of the organization, the type must already be registered with the type registry of the protocol
+func (s *DefaultAPIService) OrganizationsOrganizationIdCredentialTypesPost(ctx context.Context, organizationId string, credentialTypeInput CredentialTypeInput) (ImplResponse, error) {
+	// TODO - update OrganizationsOrganizationIdCredentialTypesPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, CredentialType{}) or use other options such as http.Ok ...
+	// return Response(201, CredentialType{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(409, {}) or use other options such as http.Ok ...
+	// return Response(409, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsOrganizationIdCredentialTypesPost method not implemented")
+}
+
+// OrganizationsOrganizationIdEventsGet - List every event of an organization, including drafts and scheduled events
+func (s *DefaultAPIService) OrganizationsOrganizationIdEventsGet(ctx context.Context, organizationId string) (ImplResponse, error) {
+	// TODO - update OrganizationsOrganizationIdEventsGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []Event{}) or use other options such as http.Ok ...
+	// return Response(200, []Event{}), nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsOrganizationIdEventsGet method not implemented")
+}
+
+// OrganizationsOrganizationIdMembersGet - List the members of an organization
+func (s *DefaultAPIService) OrganizationsOrganizationIdMembersGet(ctx context.Context, organizationId string) (ImplResponse, error) {
+	// TODO - update OrganizationsOrganizationIdMembersGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []OrganizationMember{}) or use other options such as http.Ok ...
+	// return Response(200, []OrganizationMember{}), nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsOrganizationIdMembersGet method not implemented")
+}
+
+// OrganizationsOrganizationIdMembersPut - Add a member to an organization or change the role of a member
+func (s *DefaultAPIService) OrganizationsOrganizationIdMembersPut(ctx context.Context, organizationId string, organizationMemberInput OrganizationMemberInput) (ImplResponse, error) {
+	// TODO - update OrganizationsOrganizationIdMembersPut with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, OrganizationMember{}) or use other options such as http.Ok ...
+	// return Response(200, OrganizationMember{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsOrganizationIdMembersPut method not implemented")
+}
+
+// OrganizationsOrganizationIdMembersUserIdDelete - Remove a member from an organization
+func (s *DefaultAPIService) OrganizationsOrganizationIdMembersUserIdDelete(ctx context.Context, organizationId string, userId string) (ImplResponse, error) {
+	// TODO - update OrganizationsOrganizationIdMembersUserIdDelete with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(204, {}) or use other options such as http.Ok ...
+	// return Response(204, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(403, {}) or use other options such as http.Ok ...
+	// return Response(403, nil),nil
+
+	// TODO: Uncomment the next line to return response Response(404, {}) or use other options such as http.Ok ...
+	// return Response(404, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsOrganizationIdMembersUserIdDelete method not implemented")
+}
+
+// OrganizationsPost - Create an organization, the user becomes its owner
+func (s *DefaultAPIService) OrganizationsPost(ctx context.Context, organizationInput OrganizationInput) (ImplResponse, error) {
+	// TODO - update OrganizationsPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, Organization{}) or use other options such as http.Ok ...
+	// return Response(201, Organization{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("OrganizationsPost method not implemented")
+}
+
 // UserLoginPost - User login
 func (s *DefaultAPIService) UserLoginPost(ctx context.Context, userLogin UserLogin) (ImplResponse, error) {
 	// TODO - update UserLoginPost with the required logic for this service method.
@@ -93,6 +712,17 @@ func (s *DefaultAPIService) UserLoginPost(ctx context.Context, userLogin UserLog
 	return Response(http.StatusNotImplemented, nil), errors.New("UserLoginPost method not implemented")
 }
 
+// UserMeCredentialsGet - Get the credentials issued to the user by event organizers
+func (s *DefaultAPIService) UserMeCredentialsGet(ctx context.Context) (ImplResponse, error) {
+	// TODO - update UserMeCredentialsGet with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(200, []EventCredential{}) or use other options such as http.Ok ...
+	// return Response(200, []EventCredential{}), nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UserMeCredentialsGet method not implemented")
+}
+
 // UserMeEmailCredentialGet - Get user email credential
 func (s *DefaultAPIService) UserMeEmailCredentialGet(ctx context.Context) (ImplResponse, error) {
 	// TODO - update UserMeEmailCredentialGet with the required logic for this service method.
@@ -137,6 +767,20 @@ func (s *DefaultAPIService) UserMeRequestEmailCredentialPost(ctx context.Context
 	return Response(http.StatusNotImplemented, nil), errors.New("UserMeRequestEmailCredentialPost method not implemented")
 }
 
+// UserMeRequestEmailDomainCredentialPost - Generate a new email domain credential, with a hashed claim of the email domain so that membership of the domain can be proven without the address
+func (s *DefaultAPIService) UserMeRequestEmailDomainCredentialPost(ctx context.Context) (ImplResponse, error) {
+	// TODO - update UserMeRequestEmailDomainCredentialPost with the required logic for this service method.
+	// Add api_default_service.go to the .openapi-generator-ignore to avoid overwriting this service implementation when updating open api generation.
+
+	// TODO: Uncomment the next line to return response Response(201, UnencryptedEmailCredential{}) or use other options such as http.Ok ...
+	// return Response(201, UnencryptedEmailCredential{}), nil
+
+	// TODO: Uncomment the next line to return response Response(400, {}) or use other options such as http.Ok ...
+	// return Response(400, nil),nil
+
+	return Response(http.StatusNotImplemented, nil), errors.New("UserMeRequestEmailDomainCredentialPost method not implemented")
+}
+
 // UserMeTicketCredentialPut - Store user ticket credential with encrypted data
 func (s *DefaultAPIService) UserMeTicketCredentialPut(ctx context.Context, putTicketCredentialRequest PutTicketCredentialRequest) (ImplResponse, error) {
 	// TODO - update UserMeTicketCredentialPut with the required logic for this service method.
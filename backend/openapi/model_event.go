@@ -29,13 +29,57 @@ type Event struct {
 
 	ChainId string `json:"chain_id,omitempty"`
 
+	// Assigned by the server, unique to the event so its tickets are not accepted at any other event
 	ContextId string `json:"context_id,omitempty"`
 
+	// External nullifier check-in proofs must be generated with, derived from the context ID
+	ExternalNullifier string `json:"external_nullifier,omitempty"`
+
 	IssuerKeyId string `json:"issuer_key_id,omitempty"`
 
 	StartDate time.Time `json:"start_date,omitempty"`
 
 	EndDate time.Time `json:"end_date,omitempty"`
+
+	// Whether a ticket can be scanned more than once
+	AllowReentry bool `json:"allow_reentry,omitempty"`
+
+	// Whether check-in proofs reveal the ticket ID so that revoked tickets are rejected
+	RevocationCheck bool `json:"revocation_check,omitempty"`
+
+	OrganizationId string `json:"organization_id,omitempty"`
+
+	// Maximum number of registrations, 0 if the event has no limit
+	Capacity int32 `json:"capacity,omitempty"`
+
+	// invite_only, open or domain. Invite only events are registered by the organizer, anyone can register for open events and domain events accept the allowed email domains
+	RegistrationMode string `json:"registration_mode,omitempty"`
+
+	AllowedEmailDomains []string `json:"allowed_email_domains,omitempty"`
+
+	// Time registration closes, the end of the event if no deadline was set
+	RegistrationDeadline time.Time `json:"registration_deadline,omitempty"`
+
+	// draft, published, cancelled or archived
+	Status string `json:"status,omitempty"`
+
+	// Time a published event is listed from, it is listed right away if not set
+	PublishAt time.Time `json:"publish_at,omitempty"`
+
+	// fixed_ttl if tickets are valid for a fixed time from issuance, event_end if they expire at the end of the event
+	TicketExpiryPolicy string `json:"ticket_expiry_policy,omitempty"`
+
+	// Validity of tickets for fixed_ttl, or the grace period after the end of the event for event_end
+	TicketValiditySeconds int32 `json:"ticket_validity_seconds,omitempty"`
+
+	// Whether tickets carry the tier of the attendee as a scalar claim
+	TieredTickets bool `json:"tiered_tickets,omitempty"`
+
+	// Credential type of the tickets of the event in the credential type registry
+	TicketTypeId string `json:"ticket_type_id,omitempty"`
+
+	// Credential types organizers can issue to registrants of the event
+	CredentialTypeIds []string `json:"credential_type_ids,omitempty"`
 }
 
 // AssertEventRequired checks if the required fields are not zero-ed
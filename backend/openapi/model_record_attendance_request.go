@@ -15,17 +15,15 @@ package openapi
 
 type RecordAttendanceRequest struct {
 
-	Type string `json:"type,omitempty"`
+	// JSON encoded BabyZK proof
+	Proof string `json:"proof,omitempty"`
 
-	Context string `json:"context,omitempty"`
-
-	Nullifier string `json:"nullifier,omitempty"`
-
-	KeyId string `json:"key_id,omitempty"`
+	PublicSignals []string `json:"public_signals,omitempty"`
 
 	EventId string `json:"event_id,omitempty"`
 
-	AdminCode string `json:"admin_code,omitempty"`
+	// Lowest ticket tier let in, for the entrances of tiered events such as a VIP area. Every tier is let in if empty
+	MinTier string `json:"min_tier,omitempty"`
 }
 
 // AssertRecordAttendanceRequestRequired checks if the required fields are not zero-ed
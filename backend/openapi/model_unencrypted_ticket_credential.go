@@ -21,6 +21,9 @@ type UnencryptedTicketCredential struct {
 
 	EventId string `json:"event_id,omitempty"`
 
+	// Chain ID the credential was signed for, the chain ID of the event
+	ChainId string `json:"chain_id,omitempty"`
+
 	Credential string `json:"credential,omitempty"`
 
 	IssuedAt time.Time `json:"issued_at,omitempty"`
@@ -9,8 +9,11 @@ import (
 )
 
 type Attendance struct {
-	ID        int32
-	EventID   string
-	Nullifier string
-	CreatedAt pgtype.Timestamptz
+	ID            int32
+	EventID       string
+	Nullifier     string
+	CreatedAt     pgtype.Timestamptz
+	ScanCount     int32
+	LastScannedAt pgtype.Timestamptz
+	ScannerID     pgtype.Text
 }
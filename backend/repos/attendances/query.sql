@@ -1,10 +1,69 @@
--- name: GetAttendancesByEventIdAndNullifier :many
+-- name: GetOneByEventIdAndNullifier :one
 SELECT *
 FROM attendances
 WHERE event_id = @event_id
     AND nullifier = @nullifier;
 
 -- name: CreateOne :one
-INSERT INTO attendances (event_id, nullifier, created_at)
-VALUES (@event_id, @nullifier, NOW())
-RETURNING *;
\ No newline at end of file
+INSERT INTO attendances (
+        event_id,
+        nullifier,
+        scanner_id,
+        created_at,
+        last_scanned_at
+    )
+VALUES (
+        @event_id,
+        @nullifier,
+        @scanner_id,
+        @scanned_at,
+        @scanned_at
+    ) ON CONFLICT (event_id, nullifier) DO NOTHING
+RETURNING *;
+
+-- name: CreateOrRecordReentry :one
+-- scans that are not newer than the last recorded one are ignored, so replayed uploads are not counted twice
+INSERT INTO attendances (
+        event_id,
+        nullifier,
+        scanner_id,
+        created_at,
+        last_scanned_at
+    )
+VALUES (
+        @event_id,
+        @nullifier,
+        @scanner_id,
+        @scanned_at,
+        @scanned_at
+    ) ON CONFLICT (event_id, nullifier) DO
+UPDATE
+SET scan_count = attendances.scan_count + 1,
+    last_scanned_at = EXCLUDED.last_scanned_at
+WHERE attendances.last_scanned_at < EXCLUDED.last_scanned_at
+RETURNING *;
+-- name: CountByEventId :one
+SELECT COUNT(*)
+FROM attendances
+WHERE event_id = @event_id;
+
+-- name: GetHourlyCheckInsByEventId :many
+SELECT date_trunc('hour', created_at)::timestamptz AS hour,
+    COUNT(*) AS check_ins
+FROM attendances
+WHERE event_id = @event_id
+GROUP BY hour
+ORDER BY hour;
+
+-- name: GetPageByEventId :many
+-- pages are keyed by id so that exports do not load every attendance at once
+SELECT *
+FROM attendances
+WHERE event_id = @event_id
+    AND id > @after_id
+ORDER BY id
+LIMIT @page_size;
+
+-- name: DeleteByEventId :exec
+DELETE FROM attendances
+WHERE event_id = @event_id;
@@ -7,45 +7,207 @@ package attendances
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countByEventId = `-- name: CountByEventId :one
+SELECT COUNT(*)
+FROM attendances
+WHERE event_id = $1
+`
+
+func (q *Queries) CountByEventId(ctx context.Context, eventID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countByEventId, eventID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createOne = `-- name: CreateOne :one
-INSERT INTO attendances (event_id, nullifier, created_at)
-VALUES ($1, $2, NOW())
-RETURNING id, event_id, nullifier, created_at
+INSERT INTO attendances (
+        event_id,
+        nullifier,
+        scanner_id,
+        created_at,
+        last_scanned_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $4
+    ) ON CONFLICT (event_id, nullifier) DO NOTHING
+RETURNING id, event_id, nullifier, created_at, scan_count, last_scanned_at, scanner_id
 `
 
 type CreateOneParams struct {
 	EventID   string
 	Nullifier string
+	ScannerID pgtype.Text
+	ScannedAt pgtype.Timestamptz
 }
 
 func (q *Queries) CreateOne(ctx context.Context, arg CreateOneParams) (Attendance, error) {
-	row := q.db.QueryRow(ctx, createOne, arg.EventID, arg.Nullifier)
+	row := q.db.QueryRow(ctx, createOne,
+		arg.EventID,
+		arg.Nullifier,
+		arg.ScannerID,
+		arg.ScannedAt,
+	)
+	var i Attendance
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.Nullifier,
+		&i.CreatedAt,
+		&i.ScanCount,
+		&i.LastScannedAt,
+		&i.ScannerID,
+	)
+	return i, err
+}
+
+const createOrRecordReentry = `-- name: CreateOrRecordReentry :one
+INSERT INTO attendances (
+        event_id,
+        nullifier,
+        scanner_id,
+        created_at,
+        last_scanned_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $4
+    ) ON CONFLICT (event_id, nullifier) DO
+UPDATE
+SET scan_count = attendances.scan_count + 1,
+    last_scanned_at = EXCLUDED.last_scanned_at
+WHERE attendances.last_scanned_at < EXCLUDED.last_scanned_at
+RETURNING id, event_id, nullifier, created_at, scan_count, last_scanned_at, scanner_id
+`
+
+type CreateOrRecordReentryParams struct {
+	EventID   string
+	Nullifier string
+	ScannerID pgtype.Text
+	ScannedAt pgtype.Timestamptz
+}
+
+// scans that are not newer than the last recorded one are ignored, so replayed uploads are not counted twice
+func (q *Queries) CreateOrRecordReentry(ctx context.Context, arg CreateOrRecordReentryParams) (Attendance, error) {
+	row := q.db.QueryRow(ctx, createOrRecordReentry,
+		arg.EventID,
+		arg.Nullifier,
+		arg.ScannerID,
+		arg.ScannedAt,
+	)
 	var i Attendance
 	err := row.Scan(
 		&i.ID,
 		&i.EventID,
 		&i.Nullifier,
 		&i.CreatedAt,
+		&i.ScanCount,
+		&i.LastScannedAt,
+		&i.ScannerID,
 	)
 	return i, err
 }
 
-const getAttendancesByEventIdAndNullifier = `-- name: GetAttendancesByEventIdAndNullifier :many
-SELECT id, event_id, nullifier, created_at
+const deleteByEventId = `-- name: DeleteByEventId :exec
+DELETE FROM attendances
+WHERE event_id = $1
+`
+
+func (q *Queries) DeleteByEventId(ctx context.Context, eventID string) error {
+	_, err := q.db.Exec(ctx, deleteByEventId, eventID)
+	return err
+}
+
+const getHourlyCheckInsByEventId = `-- name: GetHourlyCheckInsByEventId :many
+SELECT date_trunc('hour', created_at)::timestamptz AS hour,
+    COUNT(*) AS check_ins
+FROM attendances
+WHERE event_id = $1
+GROUP BY hour
+ORDER BY hour
+`
+
+type GetHourlyCheckInsByEventIdRow struct {
+	Hour     pgtype.Timestamptz
+	CheckIns int64
+}
+
+func (q *Queries) GetHourlyCheckInsByEventId(ctx context.Context, eventID string) ([]GetHourlyCheckInsByEventIdRow, error) {
+	rows, err := q.db.Query(ctx, getHourlyCheckInsByEventId, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetHourlyCheckInsByEventIdRow
+	for rows.Next() {
+		var i GetHourlyCheckInsByEventIdRow
+		if err := rows.Scan(&i.Hour, &i.CheckIns); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOneByEventIdAndNullifier = `-- name: GetOneByEventIdAndNullifier :one
+SELECT id, event_id, nullifier, created_at, scan_count, last_scanned_at, scanner_id
 FROM attendances
 WHERE event_id = $1
     AND nullifier = $2
 `
 
-type GetAttendancesByEventIdAndNullifierParams struct {
+type GetOneByEventIdAndNullifierParams struct {
 	EventID   string
 	Nullifier string
 }
 
-func (q *Queries) GetAttendancesByEventIdAndNullifier(ctx context.Context, arg GetAttendancesByEventIdAndNullifierParams) ([]Attendance, error) {
-	rows, err := q.db.Query(ctx, getAttendancesByEventIdAndNullifier, arg.EventID, arg.Nullifier)
+func (q *Queries) GetOneByEventIdAndNullifier(ctx context.Context, arg GetOneByEventIdAndNullifierParams) (Attendance, error) {
+	row := q.db.QueryRow(ctx, getOneByEventIdAndNullifier, arg.EventID, arg.Nullifier)
+	var i Attendance
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.Nullifier,
+		&i.CreatedAt,
+		&i.ScanCount,
+		&i.LastScannedAt,
+		&i.ScannerID,
+	)
+	return i, err
+}
+
+const getPageByEventId = `-- name: GetPageByEventId :many
+SELECT id, event_id, nullifier, created_at, scan_count, last_scanned_at, scanner_id
+FROM attendances
+WHERE event_id = $1
+    AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type GetPageByEventIdParams struct {
+	EventID  string
+	AfterID  int32
+	PageSize int32
+}
+
+// pages are keyed by id so that exports do not load every attendance at once
+func (q *Queries) GetPageByEventId(ctx context.Context, arg GetPageByEventIdParams) ([]Attendance, error) {
+	rows, err := q.db.Query(ctx, getPageByEventId, arg.EventID, arg.AfterID, arg.PageSize)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +220,9 @@ func (q *Queries) GetAttendancesByEventIdAndNullifier(ctx context.Context, arg G
 			&i.EventID,
 			&i.Nullifier,
 			&i.CreatedAt,
+			&i.ScanCount,
+			&i.LastScannedAt,
+			&i.ScannerID,
 		); err != nil {
 			return nil, err
 		}
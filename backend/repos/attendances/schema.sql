@@ -2,7 +2,10 @@ CREATE TABLE attendances (
     id SERIAL PRIMARY KEY,
     event_id VARCHAR NOT NULL,
     nullifier VARCHAR NOT NULL,
-    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    scan_count INTEGER NOT NULL DEFAULT 1,
+    last_scanned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    scanner_id VARCHAR
 );
 
-CREATE INDEX idx_event_id_nullifier ON attendances(event_id, nullifier);
\ No newline at end of file
+CREATE UNIQUE INDEX idx_event_id_nullifier ON attendances(event_id, nullifier);
\ No newline at end of file
@@ -3,31 +3,64 @@ package repos
 import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/proof-pass/proof-pass/backend/repos/attendances"
+	"github.com/proof-pass/proof-pass/backend/repos/credential_types"
 	"github.com/proof-pass/proof-pass/backend/repos/email_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/event_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/event_integrations"
 	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/issued_tickets"
+	"github.com/proof-pass/proof-pass/backend/repos/offline_scans"
+	"github.com/proof-pass/proof-pass/backend/repos/organization_members"
+	"github.com/proof-pass/proof-pass/backend/repos/organizations"
 	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+	"github.com/proof-pass/proof-pass/backend/repos/scanners"
 	"github.com/proof-pass/proof-pass/backend/repos/ticket_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_issuance_jobs"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_issuances"
 	"github.com/proof-pass/proof-pass/backend/repos/users"
+	"github.com/proof-pass/proof-pass/backend/repos/waitlist"
 )
 
 type Client struct {
-	DBConnPool        *pgxpool.Pool
-	Attendances       *attendances.Queries
-	EmailCredentials  *email_credentials.Queries
-	Events            *events.Queries
-	Registrations     *registrations.Queries
-	TicketCredentials *ticket_credentials.Queries
-	Users             *users.Queries
+	DBConnPool          *pgxpool.Pool
+	Attendances         *attendances.Queries
+	CredentialTypes     *credential_types.Queries
+	EmailCredentials    *email_credentials.Queries
+	EventCredentials    *event_credentials.Queries
+	EventIntegrations   *event_integrations.Queries
+	Events              *events.Queries
+	IssuedTickets       *issued_tickets.Queries
+	OfflineScans        *offline_scans.Queries
+	OrganizationMembers *organization_members.Queries
+	Organizations       *organizations.Queries
+	Registrations       *registrations.Queries
+	Scanners            *scanners.Queries
+	TicketCredentials   *ticket_credentials.Queries
+	TicketIssuanceJobs  *ticket_issuance_jobs.Queries
+	TicketIssuances     *ticket_issuances.Queries
+	Users               *users.Queries
+	Waitlist            *waitlist.Queries
 }
 
 func NewClient(pool *pgxpool.Pool) *Client {
 	return &Client{
-		DBConnPool:        pool,
-		Attendances:       attendances.New(pool),
-		EmailCredentials:  email_credentials.New(pool),
-		Events:            events.New(pool),
-		Registrations:     registrations.New(pool),
-		TicketCredentials: ticket_credentials.New(pool),
-		Users:             users.New(pool),
+		DBConnPool:          pool,
+		Attendances:         attendances.New(pool),
+		CredentialTypes:     credential_types.New(pool),
+		EmailCredentials:    email_credentials.New(pool),
+		EventCredentials:    event_credentials.New(pool),
+		EventIntegrations:   event_integrations.New(pool),
+		Events:              events.New(pool),
+		IssuedTickets:       issued_tickets.New(pool),
+		OfflineScans:        offline_scans.New(pool),
+		OrganizationMembers: organization_members.New(pool),
+		Organizations:       organizations.New(pool),
+		Registrations:       registrations.New(pool),
+		Scanners:            scanners.New(pool),
+		TicketCredentials:   ticket_credentials.New(pool),
+		TicketIssuanceJobs:  ticket_issuance_jobs.New(pool),
+		TicketIssuances:     ticket_issuances.New(pool),
+		Users:               users.New(pool),
+		Waitlist:            waitlist.New(pool),
 	}
 }
@@ -0,0 +1,32 @@
+-- name: GetByTypeId :one
+SELECT *
+FROM credential_types
+WHERE type_id = $1;
+
+-- name: ListByOrganizationId :many
+-- Types the organization can issue, the primitive types and the types it defined
+SELECT *
+FROM credential_types
+WHERE organization_id IS NULL
+    OR organization_id = $1
+ORDER BY created_at,
+    type_id;
+
+-- name: CreateCredentialType :one
+INSERT INTO credential_types (
+        type_id,
+        name,
+        organization_id,
+        claims,
+        revocable,
+        default_ttl_seconds
+    )
+VALUES (
+        @type_id,
+        @name,
+        @organization_id,
+        @claims,
+        @revocable,
+        @default_ttl_seconds
+    ) ON CONFLICT (type_id) DO NOTHING
+RETURNING *;
@@ -0,0 +1,9 @@
+CREATE TABLE credential_types (
+    type_id VARCHAR PRIMARY KEY,
+    name VARCHAR NOT NULL,
+    organization_id VARCHAR REFERENCES organizations(id) ON DELETE CASCADE,
+    claims JSONB NOT NULL DEFAULT '[]',
+    revocable BOOLEAN NOT NULL DEFAULT FALSE,
+    default_ttl_seconds INTEGER NOT NULL CHECK (default_ttl_seconds > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
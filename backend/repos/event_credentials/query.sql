@@ -0,0 +1,90 @@
+-- name: QueueCredential :one
+-- Credentials already queued or issued for the email are left as they are
+INSERT INTO event_credentials (event_id, email, type_id, claim_values)
+VALUES (@event_id, @email, @type_id, @claim_values) ON CONFLICT (event_id, email, type_id) DO NOTHING
+RETURNING *;
+
+-- name: ListQueuedByEmail :many
+SELECT *
+FROM event_credentials
+WHERE email = $1
+    AND credential IS NULL
+ORDER BY id;
+
+-- name: ListIssuedByEmail :many
+SELECT *
+FROM event_credentials
+WHERE email = $1
+    AND credential IS NOT NULL
+ORDER BY issued_at;
+
+-- name: SetIssued :execrows
+UPDATE event_credentials
+SET credential_id = @credential_id,
+    credential = @credential,
+    issued_at = @issued_at,
+    expire_at = @expire_at
+WHERE id = @id
+    AND credential IS NULL;
+
+-- name: ListIssuedByEventIdAndTypeId :many
+SELECT *
+FROM event_credentials
+WHERE event_id = @event_id
+    AND type_id = @type_id
+    AND credential IS NOT NULL;
+
+-- name: CountByEventIdAndTypeId :one
+SELECT COUNT(*)
+FROM event_credentials
+WHERE event_id = @event_id
+    AND type_id = @type_id;
+
+-- name: CreateIssuedCredential :exec
+-- Credentials signed without being queued, such as pre-issued tickets. A queued credential is replaced.
+INSERT INTO event_credentials (
+        event_id,
+        email,
+        type_id,
+        claim_values,
+        credential_id,
+        credential,
+        issued_at,
+        expire_at
+    )
+VALUES (
+        @event_id,
+        @email,
+        @type_id,
+        @claim_values,
+        @credential_id,
+        @credential,
+        @issued_at,
+        @expire_at
+    ) ON CONFLICT (event_id, email, type_id) DO
+UPDATE
+SET claim_values = EXCLUDED.claim_values,
+    credential_id = EXCLUDED.credential_id,
+    credential = EXCLUDED.credential,
+    issued_at = EXCLUDED.issued_at,
+    expire_at = EXCLUDED.expire_at
+WHERE event_credentials.credential IS NULL;
+
+-- name: GetIssuedByEventIdEmailAndTypeId :one
+SELECT *
+FROM event_credentials
+WHERE event_id = @event_id
+    AND email = @email
+    AND type_id = @type_id
+    AND credential IS NOT NULL;
+
+-- name: DeletePreIssuedByEventIdAndEmails :execrows
+-- Tickets pre-issued to the emails, the credentials with the ID of a ticket issued for the event
+DELETE FROM event_credentials
+WHERE event_id = @event_id
+    AND email = ANY(@emails::varchar[])
+    AND credential_id IN (
+        SELECT credential_id
+        FROM issued_tickets
+        WHERE event_id = @event_id
+    );
@@ -0,0 +1,15 @@
+-- name: GetIntegrationByID :one
+SELECT *
+FROM event_integrations
+WHERE id = $1;
+
+-- name: ListIntegrationsByEventID :many
+SELECT *
+FROM event_integrations
+WHERE event_id = $1
+ORDER BY created_at;
+
+-- name: CreateIntegration :one
+INSERT INTO event_integrations (id, event_id, provider, external_event_id, secret)
+VALUES (@id, @event_id, @provider, @external_event_id, @secret) ON CONFLICT (event_id, provider, external_event_id) DO NOTHING
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,11 @@
+CREATE TABLE event_integrations (
+    id VARCHAR PRIMARY KEY,
+    event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    provider VARCHAR NOT NULL,
+    external_event_id VARCHAR NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    secret VARCHAR NOT NULL,
+    UNIQUE(event_id, provider, external_event_id)
+);
+
+CREATE INDEX idx_event_integrations_event_id ON event_integrations(event_id);
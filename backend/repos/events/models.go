@@ -9,15 +9,29 @@ import (
 )
 
 type Event struct {
-	ID          string
-	Name        string
-	Description string
-	Url         string
-	AdminCode   string
-	ChainID     string
-	ContextID   string
-	IssuerKeyID string
-	StartDate   pgtype.Timestamptz
-	EndDate     pgtype.Timestamptz
-	CreatedAt   pgtype.Timestamptz
+	ID                    string
+	Name                  string
+	Description           string
+	Url                   string
+	ChainID               string
+	ContextID             string
+	IssuerKeyID           string
+	StartDate             pgtype.Timestamptz
+	EndDate               pgtype.Timestamptz
+	CreatedAt             pgtype.Timestamptz
+	VerificationKey       string
+	AllowReentry          bool
+	OrganizationID        pgtype.Text
+	Capacity              pgtype.Int4
+	RegistrationMode      string
+	AllowedEmailDomains   []string
+	RegistrationDeadline  pgtype.Timestamptz
+	Status                string
+	PublishAt             pgtype.Timestamptz
+	TicketExpiryPolicy    string
+	TicketValiditySeconds int32
+	TieredTickets         bool
+	TicketTypeID          string
+	CredentialTypeIds     []string
+	RevocationCheck       bool
 }
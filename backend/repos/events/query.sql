@@ -3,6 +3,110 @@ SELECT *
 FROM events
 WHERE id = $1;
 
--- name: ListEvents :many
+-- name: LockEventByID :one
+-- Locks the event until the end of the transaction, so that places are given out one at a time
 SELECT *
-FROM events;
\ No newline at end of file
+FROM events
+WHERE id = $1 FOR
+UPDATE;
+
+-- name: ListPublishedEvents :many
+-- published events are listed once their publish time has passed
+SELECT *
+FROM events
+WHERE status = 'published'
+    AND (
+        publish_at IS NULL
+        OR publish_at <= NOW()
+    );
+
+-- name: ListEventsByOrganizationID :many
+SELECT *
+FROM events
+WHERE organization_id = $1
+ORDER BY start_date;
+
+-- name: CreateEvent :one
+INSERT INTO events (
+        id,
+        name,
+        description,
+        url,
+        chain_id,
+        context_id,
+        issuer_key_id,
+        start_date,
+        end_date,
+        verification_key,
+        allow_reentry,
+        organization_id,
+        capacity,
+        registration_mode,
+        allowed_email_domains,
+        registration_deadline,
+        status,
+        publish_at,
+        ticket_expiry_policy,
+        ticket_validity_seconds,
+        tiered_tickets,
+        ticket_type_id,
+        credential_type_ids,
+        revocation_check
+    )
+VALUES (
+        @id,
+        @name,
+        @description,
+        @url,
+        @chain_id,
+        @context_id,
+        @issuer_key_id,
+        @start_date,
+        @end_date,
+        @verification_key,
+        @allow_reentry,
+        @organization_id,
+        @capacity,
+        @registration_mode,
+        @allowed_email_domains,
+        @registration_deadline,
+        @status,
+        @publish_at,
+        @ticket_expiry_policy,
+        @ticket_validity_seconds,
+        @tiered_tickets,
+        @ticket_type_id,
+        @credential_type_ids,
+        @revocation_check
+    )
+RETURNING *;
+
+-- name: UpdateEvent :one
+UPDATE events
+SET name = @name,
+    description = @description,
+    url = @url,
+    chain_id = @chain_id,
+    issuer_key_id = @issuer_key_id,
+    start_date = @start_date,
+    end_date = @end_date,
+    verification_key = @verification_key,
+    allow_reentry = @allow_reentry,
+    capacity = @capacity,
+    registration_mode = @registration_mode,
+    allowed_email_domains = @allowed_email_domains,
+    registration_deadline = @registration_deadline,
+    status = @status,
+    publish_at = @publish_at,
+    ticket_expiry_policy = @ticket_expiry_policy,
+    ticket_validity_seconds = @ticket_validity_seconds,
+    tiered_tickets = @tiered_tickets,
+    ticket_type_id = @ticket_type_id,
+    credential_type_ids = @credential_type_ids,
+    revocation_check = @revocation_check
+WHERE id = @id
+RETURNING *;
+
+-- name: DeleteEvent :exec
+DELETE FROM events
+WHERE id = $1;
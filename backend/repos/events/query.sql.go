@@ -7,10 +7,163 @@ package events
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const createEvent = `-- name: CreateEvent :one
+INSERT INTO events (
+        id,
+        name,
+        description,
+        url,
+        chain_id,
+        context_id,
+        issuer_key_id,
+        start_date,
+        end_date,
+        verification_key,
+        allow_reentry,
+        organization_id,
+        capacity,
+        registration_mode,
+        allowed_email_domains,
+        registration_deadline,
+        status,
+        publish_at,
+        ticket_expiry_policy,
+        ticket_validity_seconds,
+        tiered_tickets,
+        ticket_type_id,
+        credential_type_ids,
+        revocation_check
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        $14,
+        $15,
+        $16,
+        $17,
+        $18,
+        $19,
+        $20,
+        $21,
+        $22,
+        $23,
+        $24
+    )
+RETURNING id, name, description, url, chain_id, context_id, issuer_key_id, start_date, end_date, created_at, verification_key, allow_reentry, organization_id, capacity, registration_mode, allowed_email_domains, registration_deadline, status, publish_at, ticket_expiry_policy, ticket_validity_seconds, tiered_tickets, ticket_type_id, credential_type_ids, revocation_check
+`
+
+type CreateEventParams struct {
+	ID                    string
+	Name                  string
+	Description           string
+	Url                   string
+	ChainID               string
+	ContextID             string
+	IssuerKeyID           string
+	StartDate             pgtype.Timestamptz
+	EndDate               pgtype.Timestamptz
+	VerificationKey       string
+	AllowReentry          bool
+	OrganizationID        pgtype.Text
+	Capacity              pgtype.Int4
+	RegistrationMode      string
+	AllowedEmailDomains   []string
+	RegistrationDeadline  pgtype.Timestamptz
+	Status                string
+	PublishAt             pgtype.Timestamptz
+	TicketExpiryPolicy    string
+	TicketValiditySeconds int32
+	TieredTickets         bool
+	TicketTypeID          string
+	CredentialTypeIds     []string
+	RevocationCheck       bool
+}
+
+func (q *Queries) CreateEvent(ctx context.Context, arg CreateEventParams) (Event, error) {
+	row := q.db.QueryRow(ctx, createEvent,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.Url,
+		arg.ChainID,
+		arg.ContextID,
+		arg.IssuerKeyID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.VerificationKey,
+		arg.AllowReentry,
+		arg.OrganizationID,
+		arg.Capacity,
+		arg.RegistrationMode,
+		arg.AllowedEmailDomains,
+		arg.RegistrationDeadline,
+		arg.Status,
+		arg.PublishAt,
+		arg.TicketExpiryPolicy,
+		arg.TicketValiditySeconds,
+		arg.TieredTickets,
+		arg.TicketTypeID,
+		arg.CredentialTypeIds,
+		arg.RevocationCheck,
+	)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Url,
+		&i.ChainID,
+		&i.ContextID,
+		&i.IssuerKeyID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.VerificationKey,
+		&i.AllowReentry,
+		&i.OrganizationID,
+		&i.Capacity,
+		&i.RegistrationMode,
+		&i.AllowedEmailDomains,
+		&i.RegistrationDeadline,
+		&i.Status,
+		&i.PublishAt,
+		&i.TicketExpiryPolicy,
+		&i.TicketValiditySeconds,
+		&i.TieredTickets,
+		&i.TicketTypeID,
+		&i.CredentialTypeIds,
+		&i.RevocationCheck,
+	)
+	return i, err
+}
+
+const deleteEvent = `-- name: DeleteEvent :exec
+DELETE FROM events
+WHERE id = $1
+`
+
+func (q *Queries) DeleteEvent(ctx context.Context, id string) error {
+	_, err := q.db.Exec(ctx, deleteEvent, id)
+	return err
+}
+
 const getEventByID = `-- name: GetEventByID :one
-SELECT id, name, description, url, admin_code, chain_id, context_id, issuer_key_id, start_date, end_date, created_at
+SELECT id, name, description, url, chain_id, context_id, issuer_key_id, start_date, end_date, created_at, verification_key, allow_reentry, organization_id, capacity, registration_mode, allowed_email_domains, registration_deadline, status, publish_at, ticket_expiry_policy, ticket_validity_seconds, tiered_tickets, ticket_type_id, credential_type_ids, revocation_check
 FROM events
 WHERE id = $1
 `
@@ -23,24 +176,40 @@ func (q *Queries) GetEventByID(ctx context.Context, id string) (Event, error) {
 		&i.Name,
 		&i.Description,
 		&i.Url,
-		&i.AdminCode,
 		&i.ChainID,
 		&i.ContextID,
 		&i.IssuerKeyID,
 		&i.StartDate,
 		&i.EndDate,
 		&i.CreatedAt,
+		&i.VerificationKey,
+		&i.AllowReentry,
+		&i.OrganizationID,
+		&i.Capacity,
+		&i.RegistrationMode,
+		&i.AllowedEmailDomains,
+		&i.RegistrationDeadline,
+		&i.Status,
+		&i.PublishAt,
+		&i.TicketExpiryPolicy,
+		&i.TicketValiditySeconds,
+		&i.TieredTickets,
+		&i.TicketTypeID,
+		&i.CredentialTypeIds,
+		&i.RevocationCheck,
 	)
 	return i, err
 }
 
-const listEvents = `-- name: ListEvents :many
-SELECT id, name, description, url, admin_code, chain_id, context_id, issuer_key_id, start_date, end_date, created_at
+const listEventsByOrganizationID = `-- name: ListEventsByOrganizationID :many
+SELECT id, name, description, url, chain_id, context_id, issuer_key_id, start_date, end_date, created_at, verification_key, allow_reentry, organization_id, capacity, registration_mode, allowed_email_domains, registration_deadline, status, publish_at, ticket_expiry_policy, ticket_validity_seconds, tiered_tickets, ticket_type_id, credential_type_ids, revocation_check
 FROM events
+WHERE organization_id = $1
+ORDER BY start_date
 `
 
-func (q *Queries) ListEvents(ctx context.Context) ([]Event, error) {
-	rows, err := q.db.Query(ctx, listEvents)
+func (q *Queries) ListEventsByOrganizationID(ctx context.Context, organizationID pgtype.Text) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listEventsByOrganizationID, organizationID)
 	if err != nil {
 		return nil, err
 	}
@@ -53,13 +222,27 @@ func (q *Queries) ListEvents(ctx context.Context) ([]Event, error) {
 			&i.Name,
 			&i.Description,
 			&i.Url,
-			&i.AdminCode,
 			&i.ChainID,
 			&i.ContextID,
 			&i.IssuerKeyID,
 			&i.StartDate,
 			&i.EndDate,
 			&i.CreatedAt,
+			&i.VerificationKey,
+			&i.AllowReentry,
+			&i.OrganizationID,
+			&i.Capacity,
+			&i.RegistrationMode,
+			&i.AllowedEmailDomains,
+			&i.RegistrationDeadline,
+			&i.Status,
+			&i.PublishAt,
+			&i.TicketExpiryPolicy,
+			&i.TicketValiditySeconds,
+			&i.TieredTickets,
+			&i.TicketTypeID,
+			&i.CredentialTypeIds,
+			&i.RevocationCheck,
 		); err != nil {
 			return nil, err
 		}
@@ -70,3 +253,209 @@ func (q *Queries) ListEvents(ctx context.Context) ([]Event, error) {
 	}
 	return items, nil
 }
+
+const listPublishedEvents = `-- name: ListPublishedEvents :many
+SELECT id, name, description, url, chain_id, context_id, issuer_key_id, start_date, end_date, created_at, verification_key, allow_reentry, organization_id, capacity, registration_mode, allowed_email_domains, registration_deadline, status, publish_at, ticket_expiry_policy, ticket_validity_seconds, tiered_tickets, ticket_type_id, credential_type_ids, revocation_check
+FROM events
+WHERE status = 'published'
+    AND (
+        publish_at IS NULL
+        OR publish_at <= NOW()
+    )
+`
+
+// published events are listed once their publish time has passed
+func (q *Queries) ListPublishedEvents(ctx context.Context) ([]Event, error) {
+	rows, err := q.db.Query(ctx, listPublishedEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Url,
+			&i.ChainID,
+			&i.ContextID,
+			&i.IssuerKeyID,
+			&i.StartDate,
+			&i.EndDate,
+			&i.CreatedAt,
+			&i.VerificationKey,
+			&i.AllowReentry,
+			&i.OrganizationID,
+			&i.Capacity,
+			&i.RegistrationMode,
+			&i.AllowedEmailDomains,
+			&i.RegistrationDeadline,
+			&i.Status,
+			&i.PublishAt,
+			&i.TicketExpiryPolicy,
+			&i.TicketValiditySeconds,
+			&i.TieredTickets,
+			&i.TicketTypeID,
+			&i.CredentialTypeIds,
+			&i.RevocationCheck,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockEventByID = `-- name: LockEventByID :one
+SELECT id, name, description, url, chain_id, context_id, issuer_key_id, start_date, end_date, created_at, verification_key, allow_reentry, organization_id, capacity, registration_mode, allowed_email_domains, registration_deadline, status, publish_at, ticket_expiry_policy, ticket_validity_seconds, tiered_tickets, ticket_type_id, credential_type_ids, revocation_check
+FROM events
+WHERE id = $1 FOR
+UPDATE
+`
+
+// Locks the event until the end of the transaction, so that places are given out one at a time
+func (q *Queries) LockEventByID(ctx context.Context, id string) (Event, error) {
+	row := q.db.QueryRow(ctx, lockEventByID, id)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Url,
+		&i.ChainID,
+		&i.ContextID,
+		&i.IssuerKeyID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.VerificationKey,
+		&i.AllowReentry,
+		&i.OrganizationID,
+		&i.Capacity,
+		&i.RegistrationMode,
+		&i.AllowedEmailDomains,
+		&i.RegistrationDeadline,
+		&i.Status,
+		&i.PublishAt,
+		&i.TicketExpiryPolicy,
+		&i.TicketValiditySeconds,
+		&i.TieredTickets,
+		&i.TicketTypeID,
+		&i.CredentialTypeIds,
+		&i.RevocationCheck,
+	)
+	return i, err
+}
+
+const updateEvent = `-- name: UpdateEvent :one
+UPDATE events
+SET name = $1,
+    description = $2,
+    url = $3,
+    chain_id = $4,
+    issuer_key_id = $5,
+    start_date = $6,
+    end_date = $7,
+    verification_key = $8,
+    allow_reentry = $9,
+    capacity = $10,
+    registration_mode = $11,
+    allowed_email_domains = $12,
+    registration_deadline = $13,
+    status = $14,
+    publish_at = $15,
+    ticket_expiry_policy = $16,
+    ticket_validity_seconds = $17,
+    tiered_tickets = $18,
+    ticket_type_id = $19,
+    credential_type_ids = $20,
+    revocation_check = $21
+WHERE id = $22
+RETURNING id, name, description, url, chain_id, context_id, issuer_key_id, start_date, end_date, created_at, verification_key, allow_reentry, organization_id, capacity, registration_mode, allowed_email_domains, registration_deadline, status, publish_at, ticket_expiry_policy, ticket_validity_seconds, tiered_tickets, ticket_type_id, credential_type_ids, revocation_check
+`
+
+type UpdateEventParams struct {
+	Name                  string
+	Description           string
+	Url                   string
+	ChainID               string
+	IssuerKeyID           string
+	StartDate             pgtype.Timestamptz
+	EndDate               pgtype.Timestamptz
+	VerificationKey       string
+	AllowReentry          bool
+	Capacity              pgtype.Int4
+	RegistrationMode      string
+	AllowedEmailDomains   []string
+	RegistrationDeadline  pgtype.Timestamptz
+	Status                string
+	PublishAt             pgtype.Timestamptz
+	TicketExpiryPolicy    string
+	TicketValiditySeconds int32
+	TieredTickets         bool
+	TicketTypeID          string
+	CredentialTypeIds     []string
+	RevocationCheck       bool
+	ID                    string
+}
+
+func (q *Queries) UpdateEvent(ctx context.Context, arg UpdateEventParams) (Event, error) {
+	row := q.db.QueryRow(ctx, updateEvent,
+		arg.Name,
+		arg.Description,
+		arg.Url,
+		arg.ChainID,
+		arg.IssuerKeyID,
+		arg.StartDate,
+		arg.EndDate,
+		arg.VerificationKey,
+		arg.AllowReentry,
+		arg.Capacity,
+		arg.RegistrationMode,
+		arg.AllowedEmailDomains,
+		arg.RegistrationDeadline,
+		arg.Status,
+		arg.PublishAt,
+		arg.TicketExpiryPolicy,
+		arg.TicketValiditySeconds,
+		arg.TieredTickets,
+		arg.TicketTypeID,
+		arg.CredentialTypeIds,
+		arg.RevocationCheck,
+		arg.ID,
+	)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Url,
+		&i.ChainID,
+		&i.ContextID,
+		&i.IssuerKeyID,
+		&i.StartDate,
+		&i.EndDate,
+		&i.CreatedAt,
+		&i.VerificationKey,
+		&i.AllowReentry,
+		&i.OrganizationID,
+		&i.Capacity,
+		&i.RegistrationMode,
+		&i.AllowedEmailDomains,
+		&i.RegistrationDeadline,
+		&i.Status,
+		&i.PublishAt,
+		&i.TicketExpiryPolicy,
+		&i.TicketValiditySeconds,
+		&i.TieredTickets,
+		&i.TicketTypeID,
+		&i.CredentialTypeIds,
+		&i.RevocationCheck,
+	)
+	return i, err
+}
@@ -3,11 +3,25 @@ CREATE TABLE events (
     name VARCHAR NOT NULL,
     description VARCHAR NOT NULL,
     url VARCHAR NOT NULL,
-    admin_code VARCHAR NOT NULL,
     chain_id VARCHAR NOT NULL,
-    context_id VARCHAR NOT NULL,
+    context_id VARCHAR NOT NULL UNIQUE,
     issuer_key_id VARCHAR NOT NULL,
     start_date TIMESTAMPTZ NOT NULL,
     end_date TIMESTAMPTZ NOT NULL,
-    created_at TIMESTAMPTZ DEFAULT NOW()
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    verification_key VARCHAR NOT NULL DEFAULT '',
+    allow_reentry BOOLEAN NOT NULL DEFAULT FALSE,
+    organization_id VARCHAR REFERENCES organizations(id) ON DELETE CASCADE,
+    capacity INTEGER CHECK (capacity > 0),
+    registration_mode VARCHAR NOT NULL DEFAULT 'invite_only' CHECK (registration_mode IN ('invite_only', 'open', 'domain')),
+    allowed_email_domains VARCHAR[] NOT NULL DEFAULT '{}',
+    registration_deadline TIMESTAMPTZ,
+    status VARCHAR NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published', 'cancelled', 'archived')),
+    publish_at TIMESTAMPTZ,
+    ticket_expiry_policy VARCHAR NOT NULL DEFAULT 'fixed_ttl' CHECK (ticket_expiry_policy IN ('fixed_ttl', 'event_end')),
+    ticket_validity_seconds INTEGER NOT NULL DEFAULT 31536000 CHECK (ticket_validity_seconds >= 0),
+    tiered_tickets BOOLEAN NOT NULL DEFAULT FALSE,
+    ticket_type_id VARCHAR NOT NULL REFERENCES credential_types(type_id),
+    credential_type_ids VARCHAR[] NOT NULL DEFAULT '{}',
+    revocation_check BOOLEAN NOT NULL DEFAULT FALSE
 );
\ No newline at end of file
@@ -0,0 +1,52 @@
+-- name: CreateIssuedTicket :execrows
+-- Nothing is inserted if the registrant already holds an active ticket for the event
+INSERT INTO issued_tickets (credential_id, event_id, email)
+VALUES (@credential_id, @event_id, @email)
+ON CONFLICT (event_id, email) WHERE revoked_at IS NULL DO NOTHING;
+
+-- name: GetByCredentialID :one
+SELECT *
+FROM issued_tickets
+WHERE credential_id = $1;
+
+-- name: ListRevokedByEventId :many
+SELECT *
+FROM issued_tickets
+WHERE event_id = $1
+    AND revoked_at IS NOT NULL
+ORDER BY revoked_at;
+
+-- name: RevokeByEventIdAndEmails :many
+-- Tickets that are already revoked keep their original revocation time and reason
+UPDATE issued_tickets
+SET revoked_at = NOW(),
+    revocation_reason = @revocation_reason
+WHERE event_id = @event_id
+    AND email = ANY(@emails::varchar[])
+    AND revoked_at IS NULL
+RETURNING *;
+
+-- name: CountActiveByEventIdAndEmail :one
+SELECT COUNT(*)
+FROM issued_tickets
+WHERE event_id = @event_id
+    AND email = @email
+    AND revoked_at IS NULL;
+
+-- name: ListActiveByEventId :many
+SELECT *
+FROM issued_tickets
+WHERE event_id = $1
+    AND revoked_at IS NULL;
+
+-- name: DeleteByCredentialID :exec
+-- Tickets that were recorded but never signed, so that they can be issued again
+DELETE FROM issued_tickets
+WHERE credential_id = $1;
+
+-- name: RevokeByCredentialID :exec
+UPDATE issued_tickets
+SET revoked_at = NOW(),
+    revocation_reason = @revocation_reason
+WHERE credential_id = @credential_id
+    AND revoked_at IS NULL;
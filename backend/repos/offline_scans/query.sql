@@ -0,0 +1,11 @@
+-- name: GetByScannerIdAndNonce :one
+SELECT *
+FROM offline_scans
+WHERE scanner_id = @scanner_id
+    AND nonce = @nonce;
+
+-- name: CreateOne :one
+-- Nothing is returned if the scan was already recorded by a concurrent upload
+INSERT INTO offline_scans (scanner_id, nonce, event_id, nullifier, status)
+VALUES (@scanner_id, @nonce, @event_id, @nullifier, @status) ON CONFLICT (scanner_id, nonce) DO NOTHING
+RETURNING *;
@@ -0,0 +1,34 @@
+-- name: GetMember :one
+SELECT *
+FROM organization_members
+WHERE organization_id = @organization_id
+    AND user_id = @user_id;
+
+-- name: ListMembers :many
+SELECT *
+FROM organization_members
+WHERE organization_id = $1
+ORDER BY created_at;
+
+-- name: ListMembershipsByUserID :many
+SELECT *
+FROM organization_members
+WHERE user_id = $1;
+
+-- name: CountOwners :one
+SELECT COUNT(*)
+FROM organization_members
+WHERE organization_id = $1
+    AND role = 'owner';
+
+-- name: UpsertMember :one
+INSERT INTO organization_members (organization_id, user_id, role)
+VALUES (@organization_id, @user_id, @role) ON CONFLICT (organization_id, user_id) DO
+UPDATE
+SET role = EXCLUDED.role
+RETURNING *;
+
+-- name: DeleteMember :execrows
+DELETE FROM organization_members
+WHERE organization_id = @organization_id
+    AND user_id = @user_id;
\ No newline at end of file
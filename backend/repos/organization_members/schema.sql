@@ -0,0 +1,9 @@
+CREATE TABLE organization_members (
+    organization_id VARCHAR NOT NULL REFERENCES organizations(id) ON DELETE CASCADE,
+    user_id VARCHAR NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR NOT NULL CHECK (role IN ('owner', 'admin', 'scanner')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (organization_id, user_id)
+);
+
+CREATE INDEX idx_organization_members_user_id ON organization_members(user_id);
\ No newline at end of file
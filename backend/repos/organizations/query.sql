@@ -0,0 +1,15 @@
+-- name: GetOrganizationByID :one
+SELECT *
+FROM organizations
+WHERE id = $1;
+
+-- name: ListOrganizationsByIDs :many
+SELECT *
+FROM organizations
+WHERE id = ANY(@ids::varchar[])
+ORDER BY created_at;
+
+-- name: CreateOrganization :one
+INSERT INTO organizations (id, name)
+VALUES (@id, @name)
+RETURNING *;
\ No newline at end of file
@@ -8,4 +8,5 @@ type Registration struct {
 	ID      int32
 	EventID string
 	Email   string
+	Tier    string
 }
@@ -12,4 +12,42 @@ WHERE email = $1;
 SELECT *
 FROM registrations
 WHERE event_id = @event_id
-    AND email = @email;
\ No newline at end of file
+    AND email = @email;
+
+-- name: CountEventRegistrations :one
+SELECT COUNT(*)
+FROM registrations
+WHERE event_id = $1;
+
+-- name: GetEventRegistrationsPage :many
+-- pages are keyed by id so that exports do not load every registration at once
+SELECT *
+FROM registrations
+WHERE event_id = @event_id
+    AND id > @after_id
+ORDER BY id
+LIMIT @page_size;
+
+-- name: CreateOrIgnoreRegistration :one
+INSERT INTO registrations (event_id, email)
+VALUES (@event_id, @email) ON CONFLICT (event_id, email) DO NOTHING
+RETURNING *;
+
+-- name: DeleteEventRegistrationsNotIn :many
+DELETE FROM registrations
+WHERE event_id = @event_id
+    AND NOT (email = ANY(@emails::varchar[]))
+RETURNING *;
+
+
+-- name: DeleteOneByEventIdAndEmail :execrows
+DELETE FROM registrations
+WHERE event_id = @event_id
+    AND email = @email;
+
+-- name: UpdateTier :one
+UPDATE registrations
+SET tier = @tier
+WHERE event_id = @event_id
+    AND email = @email
+RETURNING *;
@@ -9,8 +9,100 @@ import (
 	"context"
 )
 
+const countEventRegistrations = `-- name: CountEventRegistrations :one
+SELECT COUNT(*)
+FROM registrations
+WHERE event_id = $1
+`
+
+func (q *Queries) CountEventRegistrations(ctx context.Context, eventID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countEventRegistrations, eventID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOrIgnoreRegistration = `-- name: CreateOrIgnoreRegistration :one
+INSERT INTO registrations (event_id, email)
+VALUES ($1, $2) ON CONFLICT (event_id, email) DO NOTHING
+RETURNING id, event_id, email, tier
+`
+
+type CreateOrIgnoreRegistrationParams struct {
+	EventID string
+	Email   string
+}
+
+func (q *Queries) CreateOrIgnoreRegistration(ctx context.Context, arg CreateOrIgnoreRegistrationParams) (Registration, error) {
+	row := q.db.QueryRow(ctx, createOrIgnoreRegistration, arg.EventID, arg.Email)
+	var i Registration
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.Email,
+		&i.Tier,
+	)
+	return i, err
+}
+
+const deleteEventRegistrationsNotIn = `-- name: DeleteEventRegistrationsNotIn :many
+DELETE FROM registrations
+WHERE event_id = $1
+    AND NOT (email = ANY($2::varchar[]))
+RETURNING id, event_id, email, tier
+`
+
+type DeleteEventRegistrationsNotInParams struct {
+	EventID string
+	Emails  []string
+}
+
+func (q *Queries) DeleteEventRegistrationsNotIn(ctx context.Context, arg DeleteEventRegistrationsNotInParams) ([]Registration, error) {
+	rows, err := q.db.Query(ctx, deleteEventRegistrationsNotIn, arg.EventID, arg.Emails)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Registration
+	for rows.Next() {
+		var i Registration
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.Email,
+			&i.Tier,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const deleteOneByEventIdAndEmail = `-- name: DeleteOneByEventIdAndEmail :execrows
+DELETE FROM registrations
+WHERE event_id = $1
+    AND email = $2
+`
+
+type DeleteOneByEventIdAndEmailParams struct {
+	EventID string
+	Email   string
+}
+
+func (q *Queries) DeleteOneByEventIdAndEmail(ctx context.Context, arg DeleteOneByEventIdAndEmailParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteOneByEventIdAndEmail, arg.EventID, arg.Email)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getEventRegistrations = `-- name: GetEventRegistrations :many
-SELECT id, event_id, email
+SELECT id, event_id, email, tier
 FROM registrations
 WHERE event_id = $1
 `
@@ -24,7 +116,53 @@ func (q *Queries) GetEventRegistrations(ctx context.Context, eventID string) ([]
 	var items []Registration
 	for rows.Next() {
 		var i Registration
-		if err := rows.Scan(&i.ID, &i.EventID, &i.Email); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.Email,
+			&i.Tier,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEventRegistrationsPage = `-- name: GetEventRegistrationsPage :many
+SELECT id, event_id, email, tier
+FROM registrations
+WHERE event_id = $1
+    AND id > $2
+ORDER BY id
+LIMIT $3
+`
+
+type GetEventRegistrationsPageParams struct {
+	EventID  string
+	AfterID  int32
+	PageSize int32
+}
+
+// pages are keyed by id so that exports do not load every registration at once
+func (q *Queries) GetEventRegistrationsPage(ctx context.Context, arg GetEventRegistrationsPageParams) ([]Registration, error) {
+	rows, err := q.db.Query(ctx, getEventRegistrationsPage, arg.EventID, arg.AfterID, arg.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Registration
+	for rows.Next() {
+		var i Registration
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.Email,
+			&i.Tier,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -36,7 +174,7 @@ func (q *Queries) GetEventRegistrations(ctx context.Context, eventID string) ([]
 }
 
 const getOneByEventIdAndEmail = `-- name: GetOneByEventIdAndEmail :one
-SELECT id, event_id, email
+SELECT id, event_id, email, tier
 FROM registrations
 WHERE event_id = $1
     AND email = $2
@@ -50,12 +188,17 @@ type GetOneByEventIdAndEmailParams struct {
 func (q *Queries) GetOneByEventIdAndEmail(ctx context.Context, arg GetOneByEventIdAndEmailParams) (Registration, error) {
 	row := q.db.QueryRow(ctx, getOneByEventIdAndEmail, arg.EventID, arg.Email)
 	var i Registration
-	err := row.Scan(&i.ID, &i.EventID, &i.Email)
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.Email,
+		&i.Tier,
+	)
 	return i, err
 }
 
 const getRegisteredEventsByEmail = `-- name: GetRegisteredEventsByEmail :many
-SELECT id, event_id, email
+SELECT id, event_id, email, tier
 FROM registrations
 WHERE email = $1
 `
@@ -69,7 +212,12 @@ func (q *Queries) GetRegisteredEventsByEmail(ctx context.Context, email string)
 	var items []Registration
 	for rows.Next() {
 		var i Registration
-		if err := rows.Scan(&i.ID, &i.EventID, &i.Email); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventID,
+			&i.Email,
+			&i.Tier,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -79,3 +227,29 @@ func (q *Queries) GetRegisteredEventsByEmail(ctx context.Context, email string)
 	}
 	return items, nil
 }
+
+const updateTier = `-- name: UpdateTier :one
+UPDATE registrations
+SET tier = $1
+WHERE event_id = $2
+    AND email = $3
+RETURNING id, event_id, email, tier
+`
+
+type UpdateTierParams struct {
+	Tier    string
+	EventID string
+	Email   string
+}
+
+func (q *Queries) UpdateTier(ctx context.Context, arg UpdateTierParams) (Registration, error) {
+	row := q.db.QueryRow(ctx, updateTier, arg.Tier, arg.EventID, arg.Email)
+	var i Registration
+	err := row.Scan(
+		&i.ID,
+		&i.EventID,
+		&i.Email,
+		&i.Tier,
+	)
+	return i, err
+}
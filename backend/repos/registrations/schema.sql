@@ -2,5 +2,6 @@ CREATE TABLE registrations (
     id SERIAL PRIMARY KEY,
     event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
     email VARCHAR NOT NULL,
+    tier VARCHAR NOT NULL DEFAULT 'general' CHECK (tier IN ('general', 'vip', 'speaker')),
     UNIQUE(event_id, email)
 );
\ No newline at end of file
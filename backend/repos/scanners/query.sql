@@ -0,0 +1,22 @@
+-- name: GetScannerByID :one
+SELECT *
+FROM scanners
+WHERE id = $1;
+
+-- name: ListScannersByEventID :many
+SELECT *
+FROM scanners
+WHERE event_id = $1
+ORDER BY created_at;
+
+-- name: CreateScanner :one
+INSERT INTO scanners (id, event_id, name, created_at)
+VALUES ($1, $2, $3, NOW())
+RETURNING *;
+
+-- name: RevokeScanner :one
+UPDATE scanners
+SET revoked_at = COALESCE(revoked_at, NOW())
+WHERE id = @id
+    AND event_id = @event_id
+RETURNING *;
\ No newline at end of file
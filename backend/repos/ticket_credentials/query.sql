@@ -26,4 +26,18 @@ SET id = $1,
     data = $4,
     issued_at = $5,
     expire_at = $6
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: CountByEventId :one
+SELECT COUNT(*)
+FROM ticket_credentials
+WHERE event_id = @event_id;
+
+
+-- name: DeleteByEventId :exec
+DELETE FROM ticket_credentials
+WHERE event_id = @event_id;
+-- name: DeleteByEventIdAndEmails :execrows
+DELETE FROM ticket_credentials
+WHERE event_id = @event_id
+    AND email = ANY(@emails::varchar[]);
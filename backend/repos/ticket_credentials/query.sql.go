@@ -11,6 +11,19 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countByEventId = `-- name: CountByEventId :one
+SELECT COUNT(*)
+FROM ticket_credentials
+WHERE event_id = $1
+`
+
+func (q *Queries) CountByEventId(ctx context.Context, eventID string) (int64, error) {
+	row := q.db.QueryRow(ctx, countByEventId, eventID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createOrUpdateOne = `-- name: CreateOrUpdateOne :one
 INSERT INTO ticket_credentials (
         id,
@@ -59,6 +72,35 @@ func (q *Queries) CreateOrUpdateOne(ctx context.Context, arg CreateOrUpdateOnePa
 	return i, err
 }
 
+const deleteByEventId = `-- name: DeleteByEventId :exec
+DELETE FROM ticket_credentials
+WHERE event_id = $1
+`
+
+func (q *Queries) DeleteByEventId(ctx context.Context, eventID string) error {
+	_, err := q.db.Exec(ctx, deleteByEventId, eventID)
+	return err
+}
+
+const deleteByEventIdAndEmails = `-- name: DeleteByEventIdAndEmails :execrows
+DELETE FROM ticket_credentials
+WHERE event_id = $1
+    AND email = ANY($2::varchar[])
+`
+
+type DeleteByEventIdAndEmailsParams struct {
+	EventID string
+	Emails  []string
+}
+
+func (q *Queries) DeleteByEventIdAndEmails(ctx context.Context, arg DeleteByEventIdAndEmailsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteByEventIdAndEmails, arg.EventID, arg.Emails)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getAllByEmail = `-- name: GetAllByEmail :many
 SELECT id, email, event_id, data, issued_at, expire_at
 FROM ticket_credentials
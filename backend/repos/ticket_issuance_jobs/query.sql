@@ -0,0 +1,43 @@
+-- name: CreateJob :one
+-- Returns no row if a job is already running for the event
+INSERT INTO ticket_issuance_jobs (id, event_id)
+VALUES (@id, @event_id) ON CONFLICT (event_id)
+WHERE status = 'running' DO NOTHING
+RETURNING *;
+
+-- name: GetJob :one
+SELECT *
+FROM ticket_issuance_jobs
+WHERE id = @id
+    AND event_id = @event_id;
+
+-- name: FailStaleJobs :execrows
+-- Jobs that stopped reporting progress were interrupted, such as by a restart of the server
+UPDATE ticket_issuance_jobs
+SET status = 'failed',
+    error = 'Interrupted',
+    updated_at = NOW(),
+    completed_at = NOW()
+WHERE event_id = @event_id
+    AND status = 'running'
+    AND updated_at < @stale_before;
+
+-- name: UpdateProgress :execrows
+-- Updates no row if the job is no longer running, such as when it was failed as stale
+UPDATE ticket_issuance_jobs
+SET total = @total,
+    issued = @issued,
+    skipped = @skipped,
+    failed = @failed,
+    updated_at = NOW()
+WHERE id = @id
+    AND status = 'running';
+
+-- name: FinishJob :exec
+UPDATE ticket_issuance_jobs
+SET status = @status,
+    error = @error,
+    updated_at = NOW(),
+    completed_at = NOW()
+WHERE id = @id
+    AND status = 'running';
@@ -0,0 +1,8 @@
+-- name: CreateOne :exec
+INSERT INTO ticket_issuances (event_id)
+VALUES (@event_id);
+
+-- name: CountByEventId :one
+SELECT COUNT(*)
+FROM ticket_issuances
+WHERE event_id = @event_id;
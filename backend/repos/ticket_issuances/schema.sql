@@ -0,0 +1,7 @@
+CREATE TABLE ticket_issuances (
+    id SERIAL PRIMARY KEY,
+    event_id VARCHAR NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_ticket_issuances_event_id ON ticket_issuances(event_id);
\ No newline at end of file
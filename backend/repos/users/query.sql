@@ -27,4 +27,8 @@ SET identity_commitment = @identity_commitment,
     encrypted_internal_nullifier = @encrypted_internal_nullifier,
     encrypted_identity_secret = @encrypted_identity_secret
 WHERE id = @id
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+-- name: ListUsersByIDs :many
+SELECT *
+FROM users
+WHERE id = ANY(@ids::varchar[]);
@@ -96,6 +96,40 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 	return i, err
 }
 
+const listUsersByIDs = `-- name: ListUsersByIDs :many
+SELECT id, email, identity_commitment, encrypted_internal_nullifier, encrypted_identity_secret, is_encrypted, created_at
+FROM users
+WHERE id = ANY($1::varchar[])
+`
+
+func (q *Queries) ListUsersByIDs(ctx context.Context, ids []string) ([]User, error) {
+	rows, err := q.db.Query(ctx, listUsersByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []User
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.IdentityCommitment,
+			&i.EncryptedInternalNullifier,
+			&i.EncryptedIdentitySecret,
+			&i.IsEncrypted,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET identity_commitment = $1,
@@ -0,0 +1,43 @@
+-- name: AddToWaitlist :one
+INSERT INTO waitlist (event_id, email)
+VALUES (@event_id, @email) ON CONFLICT (event_id, email) DO NOTHING
+RETURNING *;
+
+-- name: ListByEventId :many
+SELECT *
+FROM waitlist
+WHERE event_id = $1
+ORDER BY id;
+
+-- name: GetPosition :one
+-- Position of the email on the waitlist, starting at 1
+SELECT COUNT(*)
+FROM waitlist
+WHERE event_id = @event_id
+    AND id <= (
+        SELECT w.id
+        FROM waitlist w
+        WHERE w.event_id = @event_id
+            AND w.email = @email
+    );
+
+-- name: PopNext :one
+DELETE FROM waitlist
+WHERE id = (
+        SELECT w.id
+        FROM waitlist w
+        WHERE w.event_id = $1
+        ORDER BY w.id
+        LIMIT 1
+    )
+RETURNING *;
+
+-- name: DeleteByEventIdAndEmail :execrows
+DELETE FROM waitlist
+WHERE event_id = @event_id
+    AND email = @email;
+
+-- name: DeleteByEventIdNotInEmails :execrows
+DELETE FROM waitlist
+WHERE event_id = @event_id
+    AND NOT (email = ANY(@emails::varchar[]));
@@ -2,7 +2,6 @@ package server
 
 import (
 	"net/http"
-	"regexp"
 	"strings"
 
 	"github.com/proof-pass/proof-pass/backend/jwt"
@@ -28,17 +27,10 @@ func corsMiddleware(h http.Handler) http.Handler {
 	})
 }
 
-// authMiddleware checks the Authorization header for a valid JWT
-func authMiddleware(h http.Handler, jwtService *jwt.Service) http.Handler {
+// authMiddleware checks that the request carries the token required by the policy of the route
+func authMiddleware(h http.Handler, p policy, jwtService *jwt.Service) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// Skip authentication for health check and preflight requests
-		if r.URL.Path == "/v1/health" ||
-			r.Method == http.MethodOptions ||
-			r.URL.Path == "/v1/user/login" ||
-			r.URL.Path == "/v1/user/request-verification-code" ||
-			(r.Method == http.MethodGet && r.URL.Path == "/v1/events") ||
-			(r.Method == http.MethodGet && regexp.MustCompile("^/v1/events/[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}$").MatchString(r.URL.Path)) ||
-			(r.Method == http.MethodPost && regexp.MustCompile("^/v1/events/[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}/attendance$").MatchString(r.URL.Path)) {
+		if p == policyPublic {
 			h.ServeHTTP(w, r)
 			return
 		}
@@ -56,6 +48,22 @@ func authMiddleware(h http.Handler, jwtService *jwt.Service) http.Handler {
 			return
 		}
 
+		// scanner tokens only carry the scanner and the event they are scoped to
+		if claims.ScannerID != "" {
+			if p != policyScanner {
+				http.Error(w, "User token required", http.StatusUnauthorized)
+				return
+			}
+			ctx := util.SetScannerIDInContext(r.Context(), claims.ScannerID)
+			ctx = util.SetEventIDInContext(ctx, claims.EventID)
+			h.ServeHTTP(w, r.WithContext(ctx))
+			return
+		}
+
+		if p == policyScanner {
+			http.Error(w, "Scanner token required", http.StatusUnauthorized)
+			return
+		}
 		ctx := util.SetUserIDInContext(r.Context(), claims.ID)
 		ctx = util.SetUserEmailInContext(ctx, claims.Email)
 
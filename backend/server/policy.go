@@ -0,0 +1,114 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+)
+
+// policy is the token a route requires before its handler is called
+type policy int
+
+const (
+	// policyPublic routes need no token. Routes authorized by a stream ticket or a
+	// webhook signature are public here and checked by their handler.
+	policyPublic policy = iota + 1
+	// policyUser routes need the token of a signed in user. Organization and event
+	// management routes are user routes too, their handler checks the role of the user.
+	policyUser
+	// policyScanner routes need the token of a scanner, the handler checks that the
+	// scanner is registered for the event and not revoked
+	policyScanner
+)
+
+func (p policy) String() string {
+	switch p {
+	case policyPublic:
+		return "public"
+	case policyUser:
+		return "user"
+	case policyScanner:
+		return "scanner"
+	default:
+		return "unknown"
+	}
+}
+
+// routePolicies declares the policy of every route by name. Routes without a policy
+// fail the server at startup, so a new endpoint is never public or locked by accident.
+var routePolicies = map[string]policy{
+	"EventsEventIdAttendanceBatchPost":               policyScanner,
+	"EventsEventIdAttendancePost":                    policyScanner,
+	"EventsEventIdAttendanceStreamGet":               policyPublic,
+	"EventsEventIdAttendanceStreamTicketPost":        policyUser,
+	"EventsEventIdCredentialsPost":                   policyUser,
+	"EventsEventIdDelete":                            policyUser,
+	"EventsEventIdExportAttendancesGet":              policyUser,
+	"EventsEventIdExportRegistrationsGet":            policyUser,
+	"EventsEventIdGet":                               policyPublic,
+	"EventsEventIdIntegrationsGet":                   policyUser,
+	"EventsEventIdIntegrationsPost":                  policyUser,
+	"EventsEventIdManifestGet":                       policyScanner,
+	"EventsEventIdPut":                               policyUser,
+	"EventsEventIdRegisterPost":                      policyUser,
+	"EventsEventIdRegistrationDelete":                policyUser,
+	"EventsEventIdRegistrationsEmailDelete":          policyUser,
+	"EventsEventIdRegistrationsEmailPut":             policyUser,
+	"EventsEventIdRegistrationsImportPost":           policyUser,
+	"EventsEventIdRequestTicketCredentialPost":       policyUser,
+	"EventsEventIdRevocationsGet":                    policyScanner,
+	"EventsEventIdRevocationsPost":                   policyUser,
+	"EventsEventIdScannersPost":                      policyUser,
+	"EventsEventIdScannersScannerIdRevokePost":       policyUser,
+	"EventsEventIdStatsGet":                          policyUser,
+	"EventsEventIdTicketIssuanceJobIdGet":            policyUser,
+	"EventsEventIdTicketIssuancePost":                policyUser,
+	"EventsEventIdWaitlistDelete":                    policyUser,
+	"EventsEventIdWaitlistGet":                       policyUser,
+	"EventsEventIdWaitlistPost":                      policyUser,
+	"EventsGet":                                      policyPublic,
+	"EventsPost":                                     policyUser,
+	"HealthGet":                                      policyPublic,
+	"OrganizationsGet":                               policyUser,
+	"OrganizationsOrganizationIdCredentialTypesGet":  policyUser,
+	"OrganizationsOrganizationIdCredentialTypesPost": policyUser,
+	"OrganizationsOrganizationIdEventsGet":           policyUser,
+	"OrganizationsOrganizationIdMembersGet":          policyUser,
+	"OrganizationsOrganizationIdMembersPut":          policyUser,
+	"OrganizationsOrganizationIdMembersUserIdDelete": policyUser,
+	"OrganizationsPost":                              policyUser,
+	"UserLoginPost":                                  policyPublic,
+	"UserMeCredentialsGet":                           policyUser,
+	"UserMeEmailCredentialGet":                       policyUser,
+	"UserMeEmailCredentialPut":                       policyUser,
+	"UserMeGet":                                      policyUser,
+	"UserMeRequestEmailCredentialPost":               policyUser,
+	"UserMeRequestEmailDomainCredentialPost":         policyUser,
+	"UserMeTicketCredentialPut":                      policyUser,
+	"UserMeTicketCredentialsGet":                     policyUser,
+	"UserRequestVerificationCodePost":                policyPublic,
+	"UserUpdatePut":                                  policyUser,
+	"WebhooksProviderIntegrationIdPost":              policyPublic,
+}
+
+// checkPolicies returns an error listing the routes without a policy and the policies without a route
+func checkPolicies(routeNames []string) error {
+	names := make(map[string]bool, len(routeNames))
+	var missing, unused []string
+	for _, name := range routeNames {
+		names[name] = true
+		if _, ok := routePolicies[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	for name := range routePolicies {
+		if !names[name] {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(unused)
+	if len(missing) > 0 || len(unused) > 0 {
+		return fmt.Errorf("routes without an auth policy: %v, auth policies without a route: %v", missing, unused)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"crypto/ed25519"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/proof-pass/proof-pass/backend/jwt"
+	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEventID = "6f1c1f3e-3b5a-4d3e-9a4b-1f0e7c2d9a10"
+
+// stubRoutes replaces the handlers with one that reports who the request was authorized for
+func stubRoutes() openapi.Routes {
+	routes := (&Server{}).routes()
+	for name, route := range routes {
+		route.HandlerFunc = func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			w.Write([]byte(util.GetUserIDFromContext(ctx) + util.GetScannerIDFromContext(ctx)))
+		}
+		routes[name] = route
+	}
+	return routes
+}
+
+func routePath(pattern string) string {
+	return strings.NewReplacer(
+		"{eventId}", testEventID,
+		"{scannerId}", "scanner-1",
+		"{organizationId}", "organization-1",
+		"{userId}", "user-2",
+		"{provider}", "generic",
+	).Replace(pattern)
+}
+
+func TestRoutePolicies(t *testing.T) {
+	routes := (&Server{}).routes()
+	names := make([]string, 0, len(routes))
+	for name := range routes {
+		names = append(names, name)
+	}
+	assert.NoError(t, checkPolicies(names))
+
+	// a route without a policy fails the router
+	routes["UnknownGet"] = openapi.Route{Method: http.MethodGet, Pattern: "/v1/unknown"}
+	_, err := newRouter(routes, jwt.NewService("secret", ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)), 3600))
+	assert.ErrorContains(t, err, "UnknownGet")
+	assert.Error(t, checkPolicies(names[1:]))
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	jwtService := jwt.NewService("secret", ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize)), 3600)
+	userToken, err := jwtService.GenerateJWT("user-1", "alice@example.com")
+	require.NoError(t, err)
+	scannerToken, err := jwtService.GenerateScannerJWT("scanner-1", testEventID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	routes := stubRoutes()
+	router, err := newRouter(routes, jwtService)
+	require.NoError(t, err)
+
+	serve := func(route openapi.Route, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(route.Method, routePath(route.Pattern), nil)
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for name, route := range routes {
+		p := routePolicies[name]
+		t.Run(name+"/"+p.String(), func(t *testing.T) {
+			noToken := serve(route, "")
+			invalidToken := serve(route, "invalid")
+			user := serve(route, userToken)
+			scanner := serve(route, scannerToken)
+
+			switch p {
+			case policyPublic:
+				assert.Equal(t, http.StatusOK, noToken.Code)
+				assert.Equal(t, http.StatusOK, invalidToken.Code)
+			case policyUser:
+				assert.Equal(t, http.StatusUnauthorized, noToken.Code)
+				assert.Equal(t, http.StatusUnauthorized, invalidToken.Code)
+				assert.Equal(t, http.StatusOK, user.Code)
+				assert.Equal(t, "user-1", user.Body.String())
+				assert.Equal(t, http.StatusUnauthorized, scanner.Code)
+			case policyScanner:
+				assert.Equal(t, http.StatusUnauthorized, noToken.Code)
+				assert.Equal(t, http.StatusUnauthorized, invalidToken.Code)
+				assert.Equal(t, http.StatusUnauthorized, user.Code)
+				assert.Equal(t, http.StatusOK, scanner.Code)
+				assert.Equal(t, "scanner-1", scanner.Body.String())
+			default:
+				t.Fatalf("route has no policy")
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/gorilla/mux"
 	"github.com/proof-pass/proof-pass/backend/jwt"
 	"github.com/proof-pass/proof-pass/backend/openapi"
 	"github.com/proof-pass/proof-pass/backend/repos"
@@ -32,14 +33,67 @@ func New(
 	}
 }
 
+// routes returns the generated API routes together with the routes the generated router cannot handle
+func (s *Server) routes() openapi.Routes {
+	routes := openapi.NewDefaultAPIController(s.apiService).Routes()
+	// streamed responses and raw request bodies, which the generated router cannot handle
+	rawRoutes := openapi.Routes{
+		"EventsEventIdAttendanceStreamGet": openapi.Route{
+			Method:      http.MethodGet,
+			Pattern:     "/v1/events/{eventId}/attendance/stream",
+			HandlerFunc: s.apiService.StreamAttendance,
+		},
+		"EventsEventIdExportAttendancesGet": openapi.Route{
+			Method:      http.MethodGet,
+			Pattern:     "/v1/events/{eventId}/export/attendances",
+			HandlerFunc: s.apiService.ExportAttendances,
+		},
+		"EventsEventIdExportRegistrationsGet": openapi.Route{
+			Method:      http.MethodGet,
+			Pattern:     "/v1/events/{eventId}/export/registrations",
+			HandlerFunc: s.apiService.ExportRegistrations,
+		},
+		"WebhooksProviderIntegrationIdPost": openapi.Route{
+			Method:      http.MethodPost,
+			Pattern:     "/v1/webhooks/{provider}/{integrationId}",
+			HandlerFunc: s.apiService.ReceiveWebhook,
+		},
+	}
+	for name, route := range rawRoutes {
+		routes[name] = route
+	}
+	return routes
+}
+
+// newRouter registers every route behind the auth middleware of its policy
+func newRouter(routes openapi.Routes, jwtService *jwt.Service) (*mux.Router, error) {
+	names := make([]string, 0, len(routes))
+	for name := range routes {
+		names = append(names, name)
+	}
+	if err := checkPolicies(names); err != nil {
+		return nil, err
+	}
+
+	router := mux.NewRouter().StrictSlash(true)
+	for name, route := range routes {
+		router.
+			Methods(route.Method).
+			Path(route.Pattern).
+			Name(name).
+			Handler(openapi.Logger(authMiddleware(route.HandlerFunc, routePolicies[name], jwtService), name))
+	}
+	return router, nil
+}
+
 func (s *Server) Start() {
-	defaultAPIController := openapi.NewDefaultAPIController(s.apiService)
-	defaultRouter := openapi.NewRouter(defaultAPIController)
-	router := authMiddleware(defaultRouter, s.jwtService)
-	router = corsMiddleware(router)
+	router, err := newRouter(s.routes(), s.jwtService)
+	if err != nil {
+		log.Fatal().Msgf("Invalid routes: %v", err)
+	}
 
 	log.Printf("Starting server on port %d", s.port)
-	err := http.ListenAndServe(fmt.Sprintf(":%d", s.port), router)
+	err = http.ListenAndServe(fmt.Sprintf(":%d", s.port), corsMiddleware(router))
 	if err != nil {
 		log.Fatal().Msgf("Server failed with error: %v", err)
 	}
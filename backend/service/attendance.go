@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/repos/attendances"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/offline_scans"
+	"github.com/proof-pass/proof-pass/backend/repos/scanners"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/rs/zerolog/log"
+)
+
+// rejection is a client error that is reported back to the caller as is
+type rejection struct {
+	status int
+	reason string
+}
+
+// authorizeScanner ensures the request carries the token of a scanner that is registered for the event
+// and has not been revoked. Rejections are logged.
+func (s *APIService) authorizeScanner(ctx context.Context, eventID string) (*scanners.Scanner, *rejection, error) {
+	logger := log.Ctx(ctx)
+
+	scannerID := util.GetScannerIDFromContext(ctx)
+	if scannerID == "" {
+		errMsg := "Scanner token required"
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusUnauthorized, errMsg}, nil
+	}
+	scanner, err := s.dbClient.Scanners.GetScannerByID(ctx, scannerID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "Unknown scanner"
+			logger.Info().Str("scannerID", scannerID).Msg(errMsg)
+			return nil, &rejection{http.StatusUnauthorized, errMsg}, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get scanner, %v", err)
+	}
+	if scanner.RevokedAt.Valid {
+		errMsg := "Scanner has been revoked"
+		logger.Info().Str("scannerID", scannerID).Msg(errMsg)
+		return nil, &rejection{http.StatusUnauthorized, errMsg}, nil
+	}
+	if scanner.EventID != eventID || util.GetEventIDFromContext(ctx) != eventID {
+		errMsg := "Scanner is not registered for this event"
+		logger.Info().Str("scannerID", scannerID).Msg(errMsg)
+		return nil, &rejection{http.StatusForbidden, errMsg}, nil
+	}
+	return &scanner, nil, nil
+}
+
+// verifyAttendanceProof verifies a check-in proof and validates its public signals against the event.
+// For tiered events, minTier is the lowest tier let in, every tier is let in if it is empty.
+// Rejections are logged.
+func (s *APIService) verifyAttendanceProof(ctx context.Context, event events.Event, proofJSON string, publicSignals []string, minTier string) (*verifiedProof, *rejection, error) {
+	logger := log.Ctx(ctx)
+
+	if minTier != "" && (!event.TieredTickets || !validTicketTier(minTier)) {
+		errMsg := fmt.Sprintf("Invalid minimum tier %q for this event", minTier)
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+
+	if eventClosed(event) {
+		errMsg := fmt.Sprintf("Event is %s, tickets are no longer checked in", event.Status)
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+	if event.VerificationKey == "" {
+		errMsg := "Event verification key not set, cannot verify proof"
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+
+	// verify proof, all checks below use the verified public signals
+	proof, err := s.verifyProof(ctx, event.VerificationKey, proofJSON, publicSignals)
+	if err != nil {
+		if err == errInvalidProof {
+			errMsg := "Invalid proof"
+			logger.Info().Msg(errMsg)
+			return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+		}
+		return nil, nil, err
+	}
+
+	// validate credential type
+	if proof.Type != event.TicketTypeID {
+		errMsg := "Invalid credential type"
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+
+	// validate credential context
+	if proof.Context != event.ContextID {
+		errMsg := "Invalid credential context"
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+
+	// validate the nullifier is the one of the event, proofs for any other external nullifier give the
+	// same ticket a fresh nullifier
+	if proof.ExternalNullifier != eventExternalNullifier(event) {
+		errMsg := "Invalid external nullifier"
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+
+	// validate credential expiration
+	if proof.ExpirationLb.Before(time.Now()) {
+		errMsg := "Credential expired"
+		logger.Info().Msg(errMsg)
+		return nil, &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+
+	// validate issuer key
+	if !matchesIssuerKey(proof.KeyID, append([]string{event.IssuerKeyID}, s.allowedIssuerKeyIDs...)...) {
+		errMsg := "Credential issuer key does not match the event issuer key"
+		logger.Info().Str("keyID", proof.KeyID).Str("expectedKeyID", event.IssuerKeyID).Msg(errMsg)
+		return nil, &rejection{http.StatusForbidden, errMsg}, nil
+	}
+
+	// validate the credential has not been revoked, for events that opted in to reveal ticket IDs
+	if event.RevocationCheck {
+		if rej, err := s.checkTicketRevocation(ctx, event, proof); err != nil || rej != nil {
+			return nil, rej, err
+		}
+	}
+
+	if minTier != "" && !tierAtLeast(proof.ClaimSignals, minTier) {
+		errMsg := fmt.Sprintf("Ticket tier is below %s", minTier)
+		logger.Info().Strs("claimSignals", proof.ClaimSignals).Msg(errMsg)
+		return nil, &rejection{http.StatusForbidden, errMsg}, nil
+	}
+
+	return proof, nil, nil
+}
+
+// checkTicketRevocation ensures the ticket proven by the proof was issued for the event and has not been
+// revoked, which needs the proof to reveal the credential ID. Rejections are logged.
+func (s *APIService) checkTicketRevocation(ctx context.Context, event events.Event, proof *verifiedProof) (*rejection, error) {
+	logger := log.Ctx(ctx)
+
+	credentialID, ok := new(big.Int).SetString(proof.CredentialID, 10)
+	if !ok || credentialID.Sign() == 0 {
+		errMsg := "Credential ID not revealed, cannot check revocation"
+		logger.Info().Msg(errMsg)
+		return &rejection{http.StatusBadRequest, errMsg}, nil
+	}
+	// a ticket that cannot be found could not be revoked either
+	ticket, err := s.dbClient.IssuedTickets.GetByCredentialID(ctx, credentialID.String())
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "Unknown ticket"
+			logger.Info().Str("credentialID", credentialID.String()).Msg(errMsg)
+			return &rejection{http.StatusForbidden, errMsg}, nil
+		}
+		return nil, fmt.Errorf("failed to get issued ticket, %v", err)
+	}
+	if ticket.EventID != event.ID {
+		errMsg := "Ticket was issued for another event"
+		logger.Info().Str("credentialID", ticket.CredentialID).Str("ticketEventID", ticket.EventID).Msg(errMsg)
+		return &rejection{http.StatusForbidden, errMsg}, nil
+	}
+	if ticket.RevokedAt.Valid {
+		errMsg := "Credential has been revoked"
+		logger.Info().Str("credentialID", ticket.CredentialID).Msg(errMsg)
+		return &rejection{http.StatusForbidden, errMsg}, nil
+	}
+	return nil, nil
+}
+
+// tierAtLeast reports whether the tier claim proven by the claim signals is at least minTier.
+// The first signal of a scalar claim is the lower bound of the range the proof shows the claim is in,
+// so the holder chooses how much of the tier is revealed.
+func tierAtLeast(claimSignals []string, minTier string) bool {
+	if len(claimSignals) == 0 {
+		return false
+	}
+	lowerBound, ok := new(big.Int).SetString(claimSignals[0], 10)
+	return ok && lowerBound.Cmp(big.NewInt(int64(ticketTierRanks[minTier]))) >= 0
+}
+
+// recordAttendance records a scan of the nullifier at scannedAt. If the scan is not recorded, because
+// the nullifier has already been checked in or, for re-entry events, a scan at the same time or later
+// has already been recorded, the existing attendance is returned with recorded set to false.
+func recordAttendance(ctx context.Context, q *attendances.Queries, event events.Event, nullifier string, scannerID string, scannedAt time.Time) (attendance attendances.Attendance, recorded bool, err error) {
+	// postgres stores microseconds, truncate so replayed scans compare equal
+	at := pgtype.Timestamptz{Time: scannedAt.Truncate(time.Microsecond), Valid: true}
+	scanner := pgtype.Text{String: scannerID, Valid: true}
+
+	// re-entry events count every scan, others only accept the first one
+	if event.AllowReentry {
+		attendance, err = q.CreateOrRecordReentry(ctx, attendances.CreateOrRecordReentryParams{
+			EventID:   event.ID,
+			Nullifier: nullifier,
+			ScannerID: scanner,
+			ScannedAt: at,
+		})
+	} else {
+		attendance, err = q.CreateOne(ctx, attendances.CreateOneParams{
+			EventID:   event.ID,
+			Nullifier: nullifier,
+			ScannerID: scanner,
+			ScannedAt: at,
+		})
+	}
+	if err == nil {
+		return attendance, true, nil
+	}
+	if err != pgx.ErrNoRows {
+		return attendance, false, fmt.Errorf("failed to record attendance, %v", err)
+	}
+
+	attendance, err = q.GetOneByEventIdAndNullifier(ctx, attendances.GetOneByEventIdAndNullifierParams{
+		EventID:   event.ID,
+		Nullifier: nullifier,
+	})
+	if err != nil {
+		return attendance, false, fmt.Errorf("failed to get existing attendance, %v", err)
+	}
+	return attendance, false, nil
+}
+
+// isReplayedScan reports whether the existing attendance was recorded from this very scan,
+// which happens when a scanner uploads the same offline batch more than once
+func isReplayedScan(attendance attendances.Attendance, allowReentry bool, scannerID string, scannedAt time.Time) bool {
+	scannedAt = scannedAt.Truncate(time.Microsecond)
+	if allowReentry {
+		return attendance.LastScannedAt.Time.Equal(scannedAt)
+	}
+	return attendance.ScannerID.String == scannerID && attendance.CreatedAt.Time.Equal(scannedAt)
+}
+
+// recordOfflineScan stores the result of an uploaded scan by its nonce and returns the result that stands,
+// the one of a concurrent upload if it recorded the scan first
+func recordOfflineScan(ctx context.Context, q *offline_scans.Queries, scan offline_scans.CreateOneParams) (string, error) {
+	recorded, err := q.CreateOne(ctx, scan)
+	if err == nil {
+		return recorded.Status, nil
+	}
+	if err != pgx.ErrNoRows {
+		return "", fmt.Errorf("failed to record uploaded scan, %v", err)
+	}
+	previous, err := q.GetByScannerIdAndNonce(ctx, offline_scans.GetByScannerIdAndNonceParams{
+		ScannerID: scan.ScannerID,
+		Nonce:     scan.Nonce,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get uploaded scan, %v", err)
+	}
+	return previous.Status, nil
+}
+
+// offlineScanTime returns the time a scan happened on the device, scans without a time or
+// with a time in the future are recorded as happening now
+func offlineScanTime(scannedAt time.Time, now time.Time) time.Time {
+	if scannedAt.IsZero() || scannedAt.After(now) {
+		return now
+	}
+	return scannedAt
+}
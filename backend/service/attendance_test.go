@@ -0,0 +1,45 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/repos/attendances"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsReplayedScan(t *testing.T) {
+	scannedAt := time.Date(2024, 7, 1, 10, 0, 0, 123456789, time.UTC)
+	stored := pgtype.Timestamptz{Time: scannedAt.Truncate(time.Microsecond), Valid: true}
+	attendance := attendances.Attendance{
+		CreatedAt:     stored,
+		LastScannedAt: stored,
+		ScannerID:     pgtype.Text{String: "scanner-1", Valid: true},
+	}
+
+	assert.True(t, isReplayedScan(attendance, false, "scanner-1", scannedAt))
+	assert.False(t, isReplayedScan(attendance, false, "scanner-2", scannedAt))
+	assert.False(t, isReplayedScan(attendance, false, "scanner-1", scannedAt.Add(time.Second)))
+
+	// re-entry scans may come from any scanner
+	assert.True(t, isReplayedScan(attendance, true, "scanner-2", scannedAt))
+	assert.False(t, isReplayedScan(attendance, true, "scanner-1", scannedAt.Add(-time.Second)))
+}
+
+func TestOfflineScanTime(t *testing.T) {
+	now := time.Now()
+
+	assert.Equal(t, now, offlineScanTime(time.Time{}, now))
+	assert.Equal(t, now, offlineScanTime(now.Add(time.Hour), now))
+	assert.Equal(t, now.Add(-time.Hour), offlineScanTime(now.Add(-time.Hour), now))
+}
+
+func TestTierAtLeast(t *testing.T) {
+	assert.True(t, tierAtLeast([]string{"2", "3"}, ticketTierGeneral))
+	assert.True(t, tierAtLeast([]string{"2", "3"}, ticketTierVIP))
+	assert.False(t, tierAtLeast([]string{"2", "3"}, ticketTierSpeaker))
+	// the claim of a ticket with no range condition proves nothing
+	assert.False(t, tierAtLeast(nil, ticketTierGeneral))
+	assert.False(t, tierAtLeast([]string{"invalid"}, ticketTierGeneral))
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/organization_members"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/rs/zerolog/log"
+)
+
+// Organization roles, each role is granted the permissions of the roles below it
+const (
+	roleOwner   = "owner"
+	roleAdmin   = "admin"
+	roleScanner = "scanner"
+)
+
+var roleRanks = map[string]int{
+	roleScanner: 1,
+	roleAdmin:   2,
+	roleOwner:   3,
+}
+
+// validRole reports whether role is one of the organization roles
+func validRole(role string) bool {
+	_, ok := roleRanks[role]
+	return ok
+}
+
+// hasRole reports whether role grants at least the permissions of required
+func hasRole(role string, required string) bool {
+	return validRole(role) && roleRanks[role] >= roleRanks[required]
+}
+
+// isPlatformAdmin reports whether the signed in user is one of the configured admins,
+// who can manage every organization and event
+func (s *APIService) isPlatformAdmin(ctx context.Context) bool {
+	email := util.GetUserEmailFromContext(ctx)
+	if email == "" {
+		return false
+	}
+	for _, adminEmail := range s.adminEmails {
+		if strings.EqualFold(email, adminEmail) {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeOrganization ensures the signed in user has at least the required role in the organization
+// and returns the role of the user. Platform admins are treated as owners. Rejections are logged.
+func (s *APIService) authorizeOrganization(ctx context.Context, organizationID string, required string) (string, *rejection, error) {
+	logger := log.Ctx(ctx)
+
+	if s.isPlatformAdmin(ctx) {
+		return roleOwner, nil, nil
+	}
+	userID := util.GetUserIDFromContext(ctx)
+	if userID == "" {
+		errMsg := "User token required"
+		logger.Info().Msg(errMsg)
+		return "", &rejection{http.StatusUnauthorized, errMsg}, nil
+	}
+	if organizationID == "" {
+		errMsg := "Only platform admins can manage events without an organization"
+		logger.Info().Msg(errMsg)
+		return "", &rejection{http.StatusForbidden, errMsg}, nil
+	}
+
+	member, err := s.dbClient.OrganizationMembers.GetMember(ctx, organization_members.GetMemberParams{
+		OrganizationID: organizationID,
+		UserID:         userID,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "User is not a member of the organization"
+			logger.Info().Str("organizationID", organizationID).Msg(errMsg)
+			return "", &rejection{http.StatusForbidden, errMsg}, nil
+		}
+		return "", nil, fmt.Errorf("failed to get organization member, %v", err)
+	}
+	if !hasRole(member.Role, required) {
+		errMsg := fmt.Sprintf("User needs the %s role in the organization", required)
+		logger.Info().Str("organizationID", organizationID).Str("role", member.Role).Msg(errMsg)
+		return "", &rejection{http.StatusForbidden, errMsg}, nil
+	}
+	return member.Role, nil, nil
+}
+
+// authorizeEvent gets the event and ensures the signed in user has at least the required role
+// in the organization of the event. Rejections are logged.
+func (s *APIService) authorizeEvent(ctx context.Context, eventID string, required string) (*events.Event, *rejection, error) {
+	logger := log.Ctx(ctx)
+
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "Event not found"
+			logger.Info().Msg(errMsg)
+			return nil, &rejection{http.StatusNotFound, errMsg}, nil
+		}
+		return nil, nil, fmt.Errorf("failed to get event, %v", err)
+	}
+	if _, rej, err := s.authorizeOrganization(ctx, event.OrganizationID.String, required); err != nil || rej != nil {
+		return nil, rej, err
+	}
+	return &event, nil, nil
+}
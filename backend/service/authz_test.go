@@ -0,0 +1,21 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasRole(t *testing.T) {
+	assert.True(t, hasRole(roleOwner, roleOwner))
+	assert.True(t, hasRole(roleOwner, roleScanner))
+	assert.True(t, hasRole(roleAdmin, roleAdmin))
+	assert.True(t, hasRole(roleAdmin, roleScanner))
+	assert.False(t, hasRole(roleAdmin, roleOwner))
+	assert.False(t, hasRole(roleScanner, roleAdmin))
+
+	// unknown roles are never granted anything
+	assert.False(t, hasRole("", roleScanner))
+	assert.False(t, hasRole("superuser", roleScanner))
+	assert.False(t, validRole("superuser"))
+}
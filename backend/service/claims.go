@@ -0,0 +1,123 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
+)
+
+// Claim types of a claim schema
+const (
+	claimTypeScalar   = "scalar"
+	claimTypeProperty = "property"
+	claimTypeBoolean  = "boolean"
+)
+
+const (
+	// maxScalarWidth is the width of the widest scalar claim
+	maxScalarWidth = 256
+	// hashedPropertyWidth is the width of property claims, hashes are truncated to fit the field
+	hashedPropertyWidth = 248
+)
+
+// claimSchema is a claim of a credential type, as stored in the credential type registry
+type claimSchema struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Width       int64  `json:"width,omitempty"`
+	EqualChecks int64  `json:"equal_checks,omitempty"`
+}
+
+// validate returns why the claim cannot be issued, or nil if it can
+func (c claimSchema) validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("claim name is required")
+	}
+	switch c.Type {
+	case claimTypeScalar:
+		if c.Width <= 0 || c.Width > maxScalarWidth || c.Width%8 != 0 {
+			return fmt.Errorf("claim %s must be a multiple of 8 bits wide, up to %d", c.Name, maxScalarWidth)
+		}
+		if c.EqualChecks != 0 {
+			return fmt.Errorf("claim %s is a scalar, only property claims have equal checks", c.Name)
+		}
+	case claimTypeProperty:
+		// property values are hashed, so the width is the one of the hash
+		if c.Width != hashedPropertyWidth {
+			return fmt.Errorf("claim %s must be %d bits wide", c.Name, hashedPropertyWidth)
+		}
+		if c.EqualChecks < 0 {
+			return fmt.Errorf("claim %s cannot have a negative number of equal checks", c.Name)
+		}
+	case claimTypeBoolean:
+		if c.Width != 0 || c.EqualChecks != 0 {
+			return fmt.Errorf("claim %s is a boolean, it has no width or equal checks", c.Name)
+		}
+	default:
+		return fmt.Errorf("claim %s has unknown type %q, must be %s, %s or %s", c.Name, c.Type, claimTypeScalar, claimTypeProperty, claimTypeBoolean)
+	}
+	return nil
+}
+
+// claimDef returns the definition of the claim sent to the issuer
+func (c claimSchema) claimDef() *issuer.ClaimDef {
+	claimType := &issuer.ClaimType{}
+	switch c.Type {
+	case claimTypeScalar:
+		claimType.Type = &issuer.ClaimType_ScalarType_{
+			ScalarType: &issuer.ClaimType_ScalarType{Width: c.Width},
+		}
+	case claimTypeProperty:
+		equalChecks := c.EqualChecks
+		claimType.Type = &issuer.ClaimType_PropertyType_{
+			PropertyType: &issuer.ClaimType_PropertyType{
+				Width:         c.Width,
+				HashAlgorithm: issuer.PropHashEnum_PROP_HASH_ENUM_CUSTOM,
+				NEqualChecks:  &equalChecks,
+			},
+		}
+	case claimTypeBoolean:
+		claimType.Type = &issuer.ClaimType_BooleanType_{
+			BooleanType: &issuer.ClaimType_BooleanType{},
+		}
+	}
+	return &issuer.ClaimDef{Name: c.Name, ClaimType: claimType}
+}
+
+// claimValue parses the value of the claim. Property values are hashed like credential IDs, so a verifier
+// can compute the hash of the value it expects and check it with an equality check, without the holder
+// revealing the value.
+func (c claimSchema) claimValue(value string) (*issuer.ClaimValue, error) {
+	switch c.Type {
+	case claimTypeScalar:
+		scalar, ok := new(big.Int).SetString(value, 10)
+		if !ok || scalar.Sign() < 0 || scalar.BitLen() > int(c.Width) {
+			return nil, fmt.Errorf("claim %s must be a non negative integer of at most %d bits", c.Name, c.Width)
+		}
+		return &issuer.ClaimValue{
+			Value: &issuer.ClaimValue_ScalarValue_{
+				ScalarValue: &issuer.ClaimValue_ScalarValue{Value: scalar.String()},
+			},
+		}, nil
+	case claimTypeProperty:
+		hash := util.StringToUint248Hash(value).String()
+		return &issuer.ClaimValue{
+			Value: &issuer.ClaimValue_PropertyValue_{
+				PropertyValue: &issuer.ClaimValue_PropertyValue{Value: value, Hash: &hash},
+			},
+		}, nil
+	case claimTypeBoolean:
+		if value != "true" && value != "false" {
+			return nil, fmt.Errorf("claim %s must be true or false", c.Name)
+		}
+		return &issuer.ClaimValue{
+			Value: &issuer.ClaimValue_BoolValue_{
+				BoolValue: &issuer.ClaimValue_BoolValue{Value: value == "true"},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("claim %s has unknown type %q", c.Name, c.Type)
+	}
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClaimSchemaValidate(t *testing.T) {
+	assert.NoError(t, claimSchema{Name: "val", Type: claimTypeScalar, Width: 256}.validate())
+	assert.NoError(t, claimSchema{Name: "val", Type: claimTypeProperty, Width: 248, EqualChecks: 1}.validate())
+	assert.NoError(t, claimSchema{Name: "val", Type: claimTypeBoolean}.validate())
+
+	assert.Error(t, claimSchema{Type: claimTypeScalar, Width: 256}.validate())
+	assert.Error(t, claimSchema{Name: "val", Type: claimTypeScalar, Width: 12}.validate())
+	assert.Error(t, claimSchema{Name: "val", Type: claimTypeScalar, Width: 264}.validate())
+	assert.Error(t, claimSchema{Name: "val", Type: claimTypeProperty, Width: 128}.validate())
+	assert.Error(t, claimSchema{Name: "val", Type: claimTypeBoolean, Width: 8}.validate())
+	assert.Error(t, claimSchema{Name: "val", Type: "string"}.validate())
+}
+
+func TestClaimSchemaValue(t *testing.T) {
+	scalar := claimSchema{Name: "val", Type: claimTypeScalar, Width: 8}
+	assert.Equal(t, int64(8), scalar.claimDef().ClaimType.GetScalarType().GetWidth())
+	value, err := scalar.claimValue("255")
+	assert.NoError(t, err)
+	assert.Equal(t, "255", value.GetScalarValue().GetValue())
+	_, err = scalar.claimValue("256")
+	assert.Error(t, err)
+	_, err = scalar.claimValue("-1")
+	assert.Error(t, err)
+
+	// the verifier only needs the value it expects to compute the hash
+	property := claimSchema{Name: "val", Type: claimTypeProperty, Width: hashedPropertyWidth, EqualChecks: 1}
+	assert.Equal(t, int64(1), property.claimDef().ClaimType.GetPropertyType().GetNEqualChecks())
+	value, err = property.claimValue("example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "example.com", value.GetPropertyValue().GetValue())
+	assert.Equal(t, util.StringToUint248Hash("example.com").String(), value.GetPropertyValue().GetHash())
+
+	boolean := claimSchema{Name: "val", Type: claimTypeBoolean}
+	value, err = boolean.claimValue("true")
+	assert.NoError(t, err)
+	assert.True(t, value.GetBoolValue().GetValue())
+	_, err = boolean.claimValue("yes")
+	assert.Error(t, err)
+}
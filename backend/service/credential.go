@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/repos/event_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
+	"github.com/rs/zerolog/log"
+)
+
+const maxCredentialRecipients = 500
+
+// statuses of the recipients of an organizer credential issuance
+const (
+	credentialIssuanceStatusIssued        = "issued"
+	credentialIssuanceStatusQueued        = "queued"
+	credentialIssuanceStatusDuplicate     = "duplicate"
+	credentialIssuanceStatusNotRegistered = "not_registered"
+	credentialIssuanceStatusInvalid       = "invalid"
+)
+
+// issuableCredentialType reports whether organizers can issue credentials of the type at the event, the
+// types registered for it other than its ticket type
+func issuableCredentialType(event events.Event, typeID string) bool {
+	return typeID != event.TicketTypeID && slices.Contains(event.CredentialTypeIds, typeID)
+}
+
+// issueEventCredential signs a queued credential for the identity commitment and stores it. The credential
+// ID is random like ticket IDs, and the credential is valid for the default TTL of its type.
+func (s *APIService) issueEventCredential(ctx context.Context, event events.Event, credentialType *credentialType, queued event_credentials.EventCredential, identityCommitment string) error {
+	if !issuableCredentialType(event, credentialType.TypeID) {
+		return fmt.Errorf("credential type %s is not registered for the event", credentialType.TypeID)
+	}
+	if !s.issuableContext(event) {
+		return fmt.Errorf("event context ID %q cannot be issued in", event.ContextID)
+	}
+	chainID, ok := parseChainID(event.ChainID)
+	if !ok {
+		return fmt.Errorf("event chain ID %q is invalid", event.ChainID)
+	}
+	values, err := credentialType.claimValues(queued.ClaimValues)
+	if err != nil {
+		return err
+	}
+	credentialID, err := util.RandomUint248()
+	if err != nil {
+		return fmt.Errorf("failed to generate credential ID, %v", err)
+	}
+
+	issuedAt := time.Now()
+	expireAt := issuedAt.Add(credentialType.defaultTTL())
+	resp, err := s.issuerClient.GenerateSignedCredential(ctx, &issuer.GenerateSignedCredentialRequest{
+		Header: &issuer.Header{
+			Version: 1,
+			Type:    credentialType.TypeID,
+			Context: event.ContextID,
+			Id:      credentialID.String(),
+		},
+		Body: &issuer.Body{
+			Tp:     credentialType.credType(),
+			Values: values,
+		},
+		Attachments: &issuer.AttachmentSet{
+			Attachments: map[string]string{"event_id": event.ID},
+		},
+		ChainId:            chainID,
+		IdentityCommitment: identityCommitment,
+		ExpiredAt:          fmt.Sprint(expireAt.Unix()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to generate credential, %v", err)
+	}
+
+	// the credential is bound to the identity commitment, so it is stored as is for the recipient to fetch
+	if _, err := s.dbClient.EventCredentials.SetIssued(ctx, event_credentials.SetIssuedParams{
+		ID:           queued.ID,
+		CredentialID: pgtype.Text{String: credentialID.String(), Valid: true},
+		Credential:   pgtype.Text{String: resp.GetSignedCred(), Valid: true},
+		IssuedAt:     pgtype.Timestamptz{Time: issuedAt, Valid: true},
+		ExpireAt:     pgtype.Timestamptz{Time: expireAt, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to store credential, %v", err)
+	}
+	return nil
+}
+
+// issueQueuedCredentials issues the credentials queued for the email, once its user has set an identity
+// commitment, and returns how many were issued. A credential that fails stays queued for the next call.
+func (s *APIService) issueQueuedCredentials(ctx context.Context, email string, identityCommitment string) (int, error) {
+	logger := log.Ctx(ctx)
+
+	queued, err := s.dbClient.EventCredentials.ListQueuedByEmail(ctx, email)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queued credentials, %v", err)
+	}
+	issued := 0
+	for _, credential := range queued {
+		credentialLogger := logger.With().Int32("credential", credential.ID).Str("eventID", credential.EventID).Str("typeID", credential.TypeID).Logger()
+		event, err := s.dbClient.Events.GetEventByID(ctx, credential.EventID)
+		if err != nil {
+			credentialLogger.Warn().Err(err).Msg("Failed to get event of queued credential")
+			continue
+		}
+		if eventClosed(event) {
+			continue
+		}
+		credentialType, err := s.getCredentialType(ctx, credential.TypeID)
+		if err != nil {
+			credentialLogger.Warn().Err(err).Msg("Failed to get type of queued credential")
+			continue
+		}
+		if err := s.issueEventCredential(ctx, event, credentialType, credential, identityCommitment); err != nil {
+			credentialLogger.Warn().Err(err).Msg("Failed to issue queued credential")
+			continue
+		}
+		issued++
+	}
+	return issued, nil
+}
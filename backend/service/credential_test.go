@@ -0,0 +1,21 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuableCredentialType(t *testing.T) {
+	event := events.Event{TicketTypeID: "1", CredentialTypeIds: []string{"3", "1234"}}
+	assert.True(t, issuableCredentialType(event, "3"))
+	assert.True(t, issuableCredentialType(event, "1234"))
+	// types not registered for the event, such as the ones of tickets, cannot be issued
+	assert.False(t, issuableCredentialType(event, "1"))
+	assert.False(t, issuableCredentialType(event, "2"))
+
+	// even if the ticket type was registered
+	event.CredentialTypeIds = append(event.CredentialTypeIds, "1")
+	assert.False(t, issuableCredentialType(event, "1"))
+}
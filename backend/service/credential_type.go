@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/repos/credential_types"
+	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
+)
+
+var errUnknownCredentialType = errors.New("unknown credential type")
+
+// credentialType is a type of the credential type registry with its parsed claim schema
+type credentialType struct {
+	credential_types.CredentialType
+	claims []claimSchema
+}
+
+// newCredentialType parses the claim schema of a registry row
+func newCredentialType(row credential_types.CredentialType) (*credentialType, error) {
+	var claims []claimSchema
+	if err := json.Unmarshal(row.Claims, &claims); err != nil {
+		return nil, fmt.Errorf("failed to parse claims of credential type %s, %v", row.TypeID, err)
+	}
+	return &credentialType{CredentialType: row, claims: claims}, nil
+}
+
+// getCredentialType looks up a type in the registry, errUnknownCredentialType is returned if it is not registered
+func (s *APIService) getCredentialType(ctx context.Context, typeID string) (*credentialType, error) {
+	row, err := s.dbClient.CredentialTypes.GetByTypeId(ctx, typeID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errUnknownCredentialType
+		}
+		return nil, fmt.Errorf("failed to get credential type, %v", err)
+	}
+	return newCredentialType(row)
+}
+
+// usableBy reports whether the organization can issue credentials of the type, the primitive types and the
+// types it defined
+func (t *credentialType) usableBy(organizationID pgtype.Text) bool {
+	return !t.OrganizationID.Valid || t.OrganizationID == organizationID
+}
+
+// fitsTickets reports whether tickets can be of the type. Tiered tickets hold the rank of their tier in a
+// single scalar claim, the other tickets have no claims.
+func (t *credentialType) fitsTickets(tiered bool) bool {
+	if !tiered {
+		return len(t.claims) == 0
+	}
+	return len(t.claims) == 1 && t.claims[0].Type == claimTypeScalar
+}
+
+// credType returns the type sent to the issuer
+func (t *credentialType) credType() *issuer.CredType {
+	revocable := int64(0)
+	if t.Revocable {
+		revocable = 1
+	}
+	claims := make([]*issuer.ClaimDef, len(t.claims))
+	for i, claim := range t.claims {
+		claims[i] = claim.claimDef()
+	}
+	return &issuer.CredType{
+		TypeId:    t.TypeID,
+		Revocable: &revocable,
+		Claims:    claims,
+	}
+}
+
+// claimValues parses one value for each claim of the type, in the order of the claims
+func (t *credentialType) claimValues(values []string) ([]*issuer.ClaimValue, error) {
+	if len(values) != len(t.claims) {
+		return nil, fmt.Errorf("credential type %s has %d claims, got %d values", t.TypeID, len(t.claims), len(values))
+	}
+	claimValues := make([]*issuer.ClaimValue, len(values))
+	for i, claim := range t.claims {
+		value, err := claim.claimValue(values[i])
+		if err != nil {
+			return nil, err
+		}
+		claimValues[i] = value
+	}
+	return claimValues, nil
+}
+
+// defaultTTL returns how long credentials of the type are valid, unless the issuance sets its own expiry
+func (t *credentialType) defaultTTL() time.Duration {
+	return time.Duration(t.DefaultTtlSeconds) * time.Second
+}
+
+// validateCredentialTypeInput returns why the type cannot be registered, or nil if it can
+func validateCredentialTypeInput(input openapi.CredentialTypeInput) error {
+	typeID, ok := new(big.Int).SetString(input.TypeId, 10)
+	if !ok || typeID.Sign() <= 0 || typeID.String() != input.TypeId {
+		return fmt.Errorf("type ID must be a positive decimal integer")
+	}
+	if input.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if input.DefaultTtlSeconds <= 0 {
+		return fmt.Errorf("default TTL must be positive")
+	}
+	names := make(map[string]bool, len(input.Claims))
+	for _, claim := range input.Claims {
+		schema := claimSchemaFromInput(claim)
+		if err := schema.validate(); err != nil {
+			return err
+		}
+		if names[schema.Name] {
+			return fmt.Errorf("claim %s is defined twice", schema.Name)
+		}
+		names[schema.Name] = true
+	}
+	return nil
+}
+
+func claimSchemaFromInput(claim openapi.ClaimSchema) claimSchema {
+	return claimSchema{
+		Name:        claim.Name,
+		Type:        claim.Type,
+		Width:       int64(claim.Width),
+		EqualChecks: int64(claim.EqualChecks),
+	}
+}
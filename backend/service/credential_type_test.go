@@ -0,0 +1,93 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/repos/credential_types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCredentialType(t *testing.T) {
+	credentialType, err := newCredentialType(credential_types.CredentialType{
+		TypeID:            "2",
+		Claims:            []byte(`[{"name": "val", "type": "scalar", "width": 256}]`),
+		Revocable:         true,
+		DefaultTtlSeconds: 3600,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, credentialType.defaultTTL())
+
+	credType := credentialType.credType()
+	assert.Equal(t, "2", credType.TypeId)
+	assert.Equal(t, int64(1), credType.GetRevocable())
+	require.Len(t, credType.Claims, 1)
+	assert.Equal(t, "val", credType.Claims[0].Name)
+
+	values, err := credentialType.claimValues([]string{ticketTierValue(ticketTierVIP)})
+	require.NoError(t, err)
+	assert.Equal(t, "2", values[0].GetScalarValue().GetValue())
+
+	// every claim needs a value
+	_, err = credentialType.claimValues(nil)
+	assert.Error(t, err)
+
+	_, err = newCredentialType(credential_types.CredentialType{TypeID: "2", Claims: []byte("{")})
+	assert.Error(t, err)
+}
+
+func TestValidateCredentialTypeInput(t *testing.T) {
+	valid := openapi.CredentialTypeInput{
+		TypeId:            "1234",
+		Name:              "volunteer",
+		Claims:            []openapi.ClaimSchema{{Name: "shift", Type: claimTypeScalar, Width: 8}},
+		DefaultTtlSeconds: 3600,
+	}
+	assert.NoError(t, validateCredentialTypeInput(valid))
+
+	for name, modify := range map[string]func(*openapi.CredentialTypeInput){
+		"hex type ID":        func(input *openapi.CredentialTypeInput) { input.TypeId = "0x10" },
+		"zero type ID":       func(input *openapi.CredentialTypeInput) { input.TypeId = "0" },
+		"missing name":       func(input *openapi.CredentialTypeInput) { input.Name = "" },
+		"no TTL":             func(input *openapi.CredentialTypeInput) { input.DefaultTtlSeconds = 0 },
+		"invalid claim":      func(input *openapi.CredentialTypeInput) { input.Claims[0].Width = 7 },
+		"duplicate claim":    func(input *openapi.CredentialTypeInput) { input.Claims = append(input.Claims, input.Claims[0]) },
+		"unknown claim type": func(input *openapi.CredentialTypeInput) { input.Claims[0].Type = "string" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			input := valid
+			input.Claims = append([]openapi.ClaimSchema{}, valid.Claims...)
+			modify(&input)
+			assert.Error(t, validateCredentialTypeInput(input))
+		})
+	}
+}
+
+func TestCredentialTypeTickets(t *testing.T) {
+	unit, err := newCredentialType(credential_types.CredentialType{TypeID: "1", Claims: []byte(`[]`)})
+	require.NoError(t, err)
+	scalar, err := newCredentialType(credential_types.CredentialType{TypeID: "2", Claims: []byte(`[{"name": "val", "type": "scalar", "width": 256}]`)})
+	require.NoError(t, err)
+	property, err := newCredentialType(credential_types.CredentialType{TypeID: "3", Claims: []byte(`[{"name": "val", "type": "property", "width": 248, "equal_checks": 1}]`)})
+	require.NoError(t, err)
+
+	// tiered tickets hold the rank of the tier in a scalar claim, the others have no claims
+	assert.True(t, unit.fitsTickets(false))
+	assert.False(t, unit.fitsTickets(true))
+	assert.True(t, scalar.fitsTickets(true))
+	assert.False(t, scalar.fitsTickets(false))
+	assert.False(t, property.fitsTickets(true))
+
+	// primitive types can be used by every organization, the others by their own organization
+	org := pgtype.Text{String: "org", Valid: true}
+	assert.True(t, unit.usableBy(org))
+	assert.True(t, unit.usableBy(pgtype.Text{}))
+	custom, err := newCredentialType(credential_types.CredentialType{TypeID: "1234", OrganizationID: org, Claims: []byte(`[]`)})
+	require.NoError(t, err)
+	assert.True(t, custom.usableBy(org))
+	assert.False(t, custom.usableBy(pgtype.Text{String: "other", Valid: true}))
+	assert.False(t, custom.usableBy(pgtype.Text{}))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"html"
 	"math/big"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -34,43 +35,56 @@ func (s *APIService) sendSigninCodeToEmail(ctx context.Context, email, code stri
 	logger := log.Ctx(ctx)
 	logger.Info().Msgf("Sending signin code %s to email %s", code, email)
 
-	if s.sesClient != nil {
-		from := loginEmailSender
-		subject := "Proof Pass Login Code"
-		htmlBody := fmt.Sprintf("<h1>Your login code is: %s</h1>", code)
-		textBody := fmt.Sprintf("Your login code is: %s", code)
+	if s.sesClient == nil {
+		logger.Warn().Msgf("Login email sending is disabled, code is %s", code)
+		return nil
+	}
+	return s.sendEmail(ctx, email, "Proof Pass Login Code",
+		fmt.Sprintf("<h1>Your login code is: %s</h1>", code),
+		fmt.Sprintf("Your login code is: %s", code))
+}
 
-		input := &ses.SendEmailInput{
-			Destination: &types.Destination{
-				ToAddresses: []string{email},
-			},
-			Message: &types.Message{
-				Body: &types.Body{
-					Html: &types.Content{
-						Charset: aws.String("UTF-8"),
-						Data:    aws.String(htmlBody),
-					},
-					Text: &types.Content{
-						Charset: aws.String("UTF-8"),
-						Data:    aws.String(textBody),
-					},
+// sendWaitlistPromotionEmail tells someone who was on the waitlist that they are now registered
+func (s *APIService) sendWaitlistPromotionEmail(ctx context.Context, email, eventName string) error {
+	logger := log.Ctx(ctx)
+
+	if s.sesClient == nil {
+		logger.Warn().Msgf("Email sending is disabled, not notifying %s of their registration", email)
+		return nil
+	}
+	return s.sendEmail(ctx, email, fmt.Sprintf("A place opened up for %s", eventName),
+		fmt.Sprintf("<h1>You are registered for %s</h1><p>A place opened up and you were next on the waitlist. Sign in to Proof Pass to request your ticket credential.</p>", html.EscapeString(eventName)),
+		fmt.Sprintf("You are registered for %s. A place opened up and you were next on the waitlist. Sign in to Proof Pass to request your ticket credential.", eventName))
+}
+
+func (s *APIService) sendEmail(ctx context.Context, email, subject, htmlBody, textBody string) error {
+	input := &ses.SendEmailInput{
+		Destination: &types.Destination{
+			ToAddresses: []string{email},
+		},
+		Message: &types.Message{
+			Body: &types.Body{
+				Html: &types.Content{
+					Charset: aws.String("UTF-8"),
+					Data:    aws.String(htmlBody),
 				},
-				Subject: &types.Content{
+				Text: &types.Content{
 					Charset: aws.String("UTF-8"),
-					Data:    aws.String(subject),
+					Data:    aws.String(textBody),
 				},
 			},
-			Source: aws.String(from),
-		}
-
-		result, err := s.sesClient.SendEmail(ctx, input)
-		if err != nil {
-			return fmt.Errorf("failed to send email, %v", err)
-		}
-		log.Printf("Email sent to address: %s, message ID: %s", email, *result.MessageId)
-	} else {
-		logger.Warn().Msgf("Login email sending is disabled, code is %s", code)
+			Subject: &types.Content{
+				Charset: aws.String("UTF-8"),
+				Data:    aws.String(subject),
+			},
+		},
+		Source: aws.String(loginEmailSender),
 	}
 
+	result, err := s.sesClient.SendEmail(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to send email, %v", err)
+	}
+	log.Printf("Email sent to address: %s, message ID: %s", email, *result.MessageId)
 	return nil
 }
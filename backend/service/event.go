@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/util"
+)
+
+// Event statuses. Drafts are only seen by the organization, published events are listed once their
+// publish time has passed, and cancelled and archived events no longer issue or check in tickets.
+const (
+	eventStatusDraft     = "draft"
+	eventStatusPublished = "published"
+	eventStatusCancelled = "cancelled"
+	eventStatusArchived  = "archived"
+)
+
+// Ticket expiry policies
+const (
+	// ticketExpiryFixedTTL tickets are valid for the validity of the event from the time they are issued
+	ticketExpiryFixedTTL = "fixed_ttl"
+	// ticketExpiryEventEnd tickets expire at the end of the event, after the validity as a grace period
+	ticketExpiryEventEnd = "event_end"
+)
+
+// eventStatusOrDefault returns the status of the input, or the current status if it is empty
+func eventStatusOrDefault(status string, current string) string {
+	if status == "" {
+		return current
+	}
+	return status
+}
+
+// eventPublished reports whether the event is published and its publish time has passed
+func eventPublished(event events.Event, now time.Time) bool {
+	return event.Status == eventStatusPublished && (!event.PublishAt.Valid || !now.Before(event.PublishAt.Time))
+}
+
+// eventVisible reports whether anyone can get the event by ID. Cancelled and archived events stay
+// visible so that attendees can see what happened to them.
+func eventVisible(event events.Event, now time.Time) bool {
+	return event.Status != eventStatusDraft && (!event.PublishAt.Valid || !now.Before(event.PublishAt.Time))
+}
+
+// ticketExpiryPolicyOrDefault returns the policy, tickets expire at the end of the event unless set otherwise
+func ticketExpiryPolicyOrDefault(policy string) string {
+	if policy == "" {
+		return ticketExpiryEventEnd
+	}
+	return policy
+}
+
+// ticketExpiry returns when a ticket issued at issuedAt expires under the expiry policy of the event
+func ticketExpiry(event events.Event, issuedAt time.Time) time.Time {
+	validity := time.Duration(event.TicketValiditySeconds) * time.Second
+	if event.TicketExpiryPolicy == ticketExpiryEventEnd {
+		return event.EndDate.Time.Add(validity)
+	}
+	return issuedAt.Add(validity)
+}
+
+// parseChainID returns the chain ID as the issuer signs it, or false if it is not a positive 64 bit number
+func parseChainID(value string) (uint64, bool) {
+	chainID, ok := new(big.Int).SetString(value, 0)
+	if !ok || chainID.Sign() <= 0 || !chainID.IsUint64() {
+		return 0, false
+	}
+	return chainID.Uint64(), true
+}
+
+// eventClosed reports whether the event no longer issues or checks in tickets
+func eventClosed(event events.Event) bool {
+	return event.Status == eventStatusCancelled || event.Status == eventStatusArchived
+}
+
+// validateEventInput returns why the event is invalid, or an empty string if it is valid
+func validateEventInput(input openapi.EventInput) string {
+	if strings.TrimSpace(input.Name) == "" {
+		return "Event name is required"
+	}
+	if input.Url != "" {
+		u, err := url.ParseRequestURI(input.Url)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			return "Event URL must be an http or https URL"
+		}
+	}
+	if _, ok := parseChainID(input.ChainId); !ok {
+		return "Chain ID must be a positive 64 bit number"
+	}
+	if !isNumber(input.IssuerKeyId) {
+		return "Issuer key ID must be a number"
+	}
+	if input.StartDate.IsZero() || input.EndDate.IsZero() {
+		return "Event start and end dates are required"
+	}
+	if !input.EndDate.After(input.StartDate) {
+		return "Event end date must be after the start date"
+	}
+	if input.Capacity < 0 {
+		return "Capacity cannot be negative"
+	}
+	switch registrationModeOrDefault(input.RegistrationMode) {
+	case registrationModeInviteOnly, registrationModeOpen:
+	case registrationModeDomain:
+		if len(input.AllowedEmailDomains) == 0 {
+			return "Domain events need at least one allowed email domain"
+		}
+	default:
+		return "Registration mode must be invite_only, open or domain"
+	}
+	for _, domain := range normalizeEmailDomains(input.AllowedEmailDomains) {
+		if !validEmailDomain(domain) {
+			return fmt.Sprintf("Invalid email domain %q", domain)
+		}
+	}
+	if !input.RegistrationDeadline.IsZero() && input.RegistrationDeadline.After(input.EndDate) {
+		return "Registration deadline cannot be after the end of the event"
+	}
+	switch input.Status {
+	case "", eventStatusDraft, eventStatusPublished, eventStatusCancelled, eventStatusArchived:
+	default:
+		return "Event status must be draft, published, cancelled or archived"
+	}
+	switch ticketExpiryPolicyOrDefault(input.TicketExpiryPolicy) {
+	case ticketExpiryEventEnd:
+	case ticketExpiryFixedTTL:
+		if input.TicketValiditySeconds <= 0 {
+			return "Ticket validity is required for a fixed ticket lifetime"
+		}
+	default:
+		return "Ticket expiry policy must be fixed_ttl or event_end"
+	}
+	if input.TicketValiditySeconds < 0 {
+		return "Ticket validity cannot be negative"
+	}
+	return ""
+}
+
+// eventTicketType returns the credential type of the tickets of an event of the organization, the given
+// type or by default the first primitive type that fits the tickets. Events checking revocation need a
+// revocable type. The message is why the type cannot be used, it is empty if the type can be used.
+func (s *APIService) eventTicketType(ctx context.Context, typeID string, organizationID pgtype.Text, tiered bool, revocationCheck bool) (string, string, error) {
+	if typeID == "" {
+		rows, err := s.dbClient.CredentialTypes.ListByOrganizationId(ctx, organizationID)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to list credential types, %v", err)
+		}
+		for _, row := range rows {
+			credentialType, err := newCredentialType(row)
+			if err != nil {
+				return "", "", err
+			}
+			if !credentialType.OrganizationID.Valid && credentialType.fitsTickets(tiered) && (credentialType.Revocable || !revocationCheck) {
+				return credentialType.TypeID, "", nil
+			}
+		}
+		return "", "No primitive credential type fits the tickets of the event", nil
+	}
+
+	credentialType, err := s.getCredentialType(ctx, typeID)
+	if err != nil {
+		if err == errUnknownCredentialType {
+			return "", "Unknown ticket credential type", nil
+		}
+		return "", "", err
+	}
+	if !credentialType.usableBy(organizationID) {
+		return "", "Ticket credential type belongs to another organization", nil
+	}
+	if !credentialType.fitsTickets(tiered) {
+		if tiered {
+			return "", "Tiered tickets need a credential type with a single scalar claim", nil
+		}
+		return "", "Tickets need a credential type without claims", nil
+	}
+	if revocationCheck && !credentialType.Revocable {
+		return "", "Revocation checks need a revocable ticket credential type", nil
+	}
+	return typeID, "", nil
+}
+
+// eventCredentialTypes returns the credential types organizers can issue at an event of the organization,
+// without duplicates. The ticket type is never one of them, credentials of it in the context of the event
+// would pass check-in. The message is why a type cannot be registered, it is empty if every type can be.
+func (s *APIService) eventCredentialTypes(ctx context.Context, typeIDs []string, organizationID pgtype.Text, ticketTypeID string) ([]string, string, error) {
+	registered := []string{}
+	for _, typeID := range typeIDs {
+		if slices.Contains(registered, typeID) {
+			continue
+		}
+		if typeID == ticketTypeID {
+			return nil, "The ticket credential type of the event cannot be issued as a credential", nil
+		}
+		credentialType, err := s.getCredentialType(ctx, typeID)
+		if err != nil {
+			if err == errUnknownCredentialType {
+				return nil, fmt.Sprintf("Unknown credential type %s", typeID), nil
+			}
+			return nil, "", err
+		}
+		if !credentialType.usableBy(organizationID) {
+			return nil, fmt.Sprintf("Credential type %s belongs to another organization", typeID), nil
+		}
+		registered = append(registered, typeID)
+	}
+	return registered, "", nil
+}
+
+// newEventContextID returns a random context ID for a new event. Every event trusts the same issuer keys,
+// so the context is what keeps tickets of an event from being accepted at another one. It is assigned by
+// the server, unique to the event and never the context of email credentials.
+func (s *APIService) newEventContextID() (string, error) {
+	for {
+		contextID, err := util.RandomUint248()
+		if err != nil {
+			return "", fmt.Errorf("failed to generate context ID, %v", err)
+		}
+		if !s.reservedContextID(contextID.String()) {
+			return contextID.String(), nil
+		}
+	}
+}
+
+// reservedContextID reports whether the context ID is one of the platform credentials, which no event
+// can issue tickets or credentials in
+func (s *APIService) reservedContextID(contextID string) bool {
+	value, ok := new(big.Int).SetString(contextID, 0)
+	return ok && value.Cmp(big.NewInt(s.emailCredentialContextID)) == 0
+}
+
+// issuableContext reports whether tickets and credentials can be issued in the context of the event
+func (s *APIService) issuableContext(event events.Event) bool {
+	return event.ContextID != "" && !s.reservedContextID(event.ContextID)
+}
+
+// isNumber reports whether value is a decimal or 0x-prefixed hex number, like chain and key IDs
+func isNumber(value string) bool {
+	_, ok := new(big.Int).SetString(value, 0)
+	return ok
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateEventInput(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour)
+	valid := openapi.EventInput{
+		Name:        "ETHCC",
+		Url:         "https://ethcc.io",
+		ChainId:     "1",
+		IssuerKeyId: "0xff",
+		StartDate:   start,
+		EndDate:     start.Add(8 * time.Hour),
+	}
+	assert.Empty(t, validateEventInput(valid))
+
+	endBeforeStart := valid
+	endBeforeStart.EndDate = start.Add(-time.Hour)
+	assert.NotEmpty(t, validateEventInput(endBeforeStart))
+
+	badURL := valid
+	badURL.Url = "javascript:alert(1)"
+	assert.NotEmpty(t, validateEventInput(badURL))
+
+	negativeCapacity := valid
+	negativeCapacity.Capacity = -1
+	assert.NotEmpty(t, validateEventInput(negativeCapacity))
+
+	domainMode := valid
+	domainMode.RegistrationMode = registrationModeDomain
+	assert.NotEmpty(t, validateEventInput(domainMode))
+	domainMode.AllowedEmailDomains = []string{"not a domain"}
+	assert.NotEmpty(t, validateEventInput(domainMode))
+	domainMode.AllowedEmailDomains = []string{"@Example.com"}
+	assert.Empty(t, validateEventInput(domainMode))
+
+	unknownMode := valid
+	unknownMode.RegistrationMode = "public"
+	assert.NotEmpty(t, validateEventInput(unknownMode))
+
+	deadlineAfterEnd := valid
+	deadlineAfterEnd.RegistrationDeadline = valid.EndDate.Add(time.Hour)
+	assert.NotEmpty(t, validateEventInput(deadlineAfterEnd))
+
+	bigChainID := valid
+	bigChainID.ChainId = "18446744073709551616"
+	assert.NotEmpty(t, validateEventInput(bigChainID))
+
+	fixedTTL := valid
+	fixedTTL.TicketExpiryPolicy = ticketExpiryFixedTTL
+	assert.NotEmpty(t, validateEventInput(fixedTTL))
+	fixedTTL.TicketValiditySeconds = 3600
+	assert.Empty(t, validateEventInput(fixedTTL))
+
+	unknownStatus := valid
+	unknownStatus.Status = "deleted"
+	assert.NotEmpty(t, validateEventInput(unknownStatus))
+}
+
+func TestEventVisibility(t *testing.T) {
+	now := time.Now()
+	published := events.Event{Status: eventStatusPublished}
+	assert.True(t, eventPublished(published, now))
+	assert.True(t, eventVisible(published, now))
+
+	draft := events.Event{Status: eventStatusDraft}
+	assert.False(t, eventPublished(draft, now))
+	assert.False(t, eventVisible(draft, now))
+
+	// scheduled events appear at their publish time
+	scheduled := events.Event{Status: eventStatusPublished, PublishAt: pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true}}
+	assert.False(t, eventPublished(scheduled, now))
+	assert.False(t, eventVisible(scheduled, now))
+	assert.True(t, eventPublished(scheduled, now.Add(time.Hour)))
+
+	// cancelled events are not listed but stay visible, and no longer issue or check in tickets
+	cancelled := events.Event{Status: eventStatusCancelled}
+	assert.False(t, eventPublished(cancelled, now))
+	assert.True(t, eventVisible(cancelled, now))
+	assert.True(t, eventClosed(cancelled))
+	assert.True(t, eventClosed(events.Event{Status: eventStatusArchived}))
+	assert.False(t, eventClosed(published))
+}
+
+func TestTicketExpiry(t *testing.T) {
+	issuedAt := time.Now()
+	end := issuedAt.Add(48 * time.Hour)
+	event := events.Event{
+		EndDate:               pgtype.Timestamptz{Time: end, Valid: true},
+		TicketExpiryPolicy:    ticketExpiryEventEnd,
+		TicketValiditySeconds: 3600,
+	}
+	assert.Equal(t, end.Add(time.Hour), ticketExpiry(event, issuedAt))
+
+	event.TicketExpiryPolicy = ticketExpiryFixedTTL
+	event.TicketValiditySeconds = 365 * 24 * 3600
+	assert.Equal(t, issuedAt.Add(365*24*time.Hour), ticketExpiry(event, issuedAt))
+}
+
+func TestEventContextID(t *testing.T) {
+	apiService := &APIService{emailCredentialContextID: 42}
+
+	contextID, err := apiService.newEventContextID()
+	require.NoError(t, err)
+	otherContextID, err := apiService.newEventContextID()
+	require.NoError(t, err)
+	assert.NotEqual(t, contextID, otherContextID)
+	assert.True(t, apiService.issuableContext(events.Event{ContextID: contextID}))
+
+	// events cannot issue in the context of email credentials, whichever way it is written
+	assert.True(t, apiService.reservedContextID("42"))
+	assert.True(t, apiService.reservedContextID("0x2a"))
+	assert.False(t, apiService.issuableContext(events.Event{ContextID: "42"}))
+	assert.False(t, apiService.issuableContext(events.Event{}))
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/proof-pass/proof-pass/backend/export"
+	"github.com/proof-pass/proof-pass/backend/repos/attendances"
+	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/rs/zerolog/log"
+)
+
+// ExportRegistrations streams the registrations of an event as CSV or NDJSON, they list the emails
+// of the registrants so only admins can export them
+func (s *APIService) ExportRegistrations(w http.ResponseWriter, r *http.Request) {
+	serveExport(s, w, r, roleAdmin, func(eventID string) *export.Table[registrations.Registration] {
+		return export.Registrations(s.dbClient.Registrations, eventID)
+	})
+}
+
+// ExportAttendances streams the anonymised attendance of an event as CSV or NDJSON
+func (s *APIService) ExportAttendances(w http.ResponseWriter, r *http.Request) {
+	serveExport(s, w, r, roleScanner, func(eventID string) *export.Table[attendances.Attendance] {
+		return export.Attendances(s.dbClient.Attendances, eventID)
+	})
+}
+
+// serveExport ensures the signed in user has at least the required role for the event, validates the
+// format and columns query parameters and streams the table. The format defaults to CSV and columns is
+// a comma separated list, all columns are exported if it is empty.
+func serveExport[T any](s *APIService, w http.ResponseWriter, r *http.Request, required string, newTable func(eventID string) *export.Table[T]) {
+	ctx := r.Context()
+	eventID := mux.Vars(r)["eventId"]
+	query := r.URL.Query()
+	table := newTable(eventID)
+	logger := log.Ctx(ctx).With().Str("op", "Export").Str("table", table.Name).Str("eventID", eventID).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventID, required)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if rej != nil {
+		http.Error(w, rej.reason, rej.status)
+		return
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = export.FormatCSV
+	}
+	var columns []string
+	if query.Get("columns") != "" {
+		columns = strings.Split(query.Get("columns"), ",")
+	}
+	if err := table.Validate(format, columns); err != nil {
+		logger.Info().Err(err).Msg("Invalid export request")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", export.ContentType(format))
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-%s.%s", table.Name, eventID, format))
+	w.WriteHeader(http.StatusOK)
+
+	// the status has been sent, a failure can only end the stream early
+	if err := table.Write(ctx, w, format, columns); err != nil {
+		logger.Err(err).Msg("Failed to write export")
+		return
+	}
+	logger.Info().Str("format", format).Msg("Exported")
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/gorilla/mux"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/rs/zerolog/log"
+)
+
+const attendanceStreamHeartbeatInterval = 15 * time.Second
+
+// attendanceStreamTicketDuration is how long a stream ticket can be used to open the stream. Tickets are
+// passed in the URL, so they are short lived and single use to be worthless once they reach a log.
+const attendanceStreamTicketDuration = time.Minute
+
+const (
+	attendanceUpdateSnapshot = "snapshot"
+	attendanceUpdateCheckIn  = "check_in"
+	attendanceUpdateReentry  = "reentry"
+	attendanceUpdateRejected = "rejected"
+	attendanceUpdateBatch    = "batch"
+)
+
+// attendanceUpdate is pushed to live dashboards whenever a scan is recorded or rejected.
+// It never identifies the attendee.
+type attendanceUpdate struct {
+	Type string `json:"type"`
+	// number of tickets checked in for the event after the update
+	CheckIns  int64  `json:"check_ins"`
+	ScannerID string `json:"scanner_id,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	// set on batch uploads only
+	Accepted int       `json:"accepted,omitempty"`
+	Rejected int       `json:"rejected,omitempty"`
+	At       time.Time `json:"at"`
+}
+
+// attendanceChannel is the Redis channel updates for an event are published on, so that every
+// replica can serve the stream regardless of which one recorded the scan
+func attendanceChannel(eventID string) string {
+	return "attendance:" + eventID
+}
+
+// publishAttendanceUpdate sets the current check-in count on the update and publishes it.
+// Failures are only logged, dashboards must not hold up check-in.
+func (s *APIService) publishAttendanceUpdate(ctx context.Context, eventID string, update attendanceUpdate) {
+	logger := log.Ctx(ctx)
+
+	count, err := s.dbClient.Attendances.CountByEventId(ctx, eventID)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to count attendance for live update")
+		return
+	}
+	update.CheckIns = count
+	update.At = time.Now()
+
+	payload, err := json.Marshal(update)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to marshal live update")
+		return
+	}
+	if err := s.redisClient.Publish(ctx, attendanceChannel(eventID), payload).Err(); err != nil {
+		logger.Warn().Err(err).Msg("Failed to publish live update")
+	}
+}
+
+// attendanceStreamGrant is the user a stream ticket was issued to, for the event it was issued for
+type attendanceStreamGrant struct {
+	EventID string `json:"event_id"`
+	UserID  string `json:"user_id"`
+	Email   string `json:"email"`
+}
+
+// createAttendanceStreamTicket stores a random ticket for the grant and returns it with its expiry
+func (s *APIService) createAttendanceStreamTicket(ctx context.Context, grant attendanceStreamGrant) (string, time.Time, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to generate stream ticket, %v", err)
+	}
+	ticket := hex.EncodeToString(buf)
+	payload, err := json.Marshal(grant)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to marshal stream grant, %v", err)
+	}
+	expireAt := time.Now().Add(attendanceStreamTicketDuration)
+	if err := s.redisClient.Set(ctx, util.GetAttendanceStreamTicketCacheKey(ticket), payload, attendanceStreamTicketDuration).Err(); err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to store stream ticket, %v", err)
+	}
+	return ticket, expireAt, nil
+}
+
+// redeemAttendanceStreamTicket consumes the ticket and returns its grant, or nil if the ticket is unknown,
+// expired or has already been used
+func (s *APIService) redeemAttendanceStreamTicket(ctx context.Context, ticket string) (*attendanceStreamGrant, error) {
+	if ticket == "" {
+		return nil, nil
+	}
+	payload, err := s.redisClient.GetDel(ctx, util.GetAttendanceStreamTicketCacheKey(ticket)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get stream ticket, %v", err)
+	}
+	var grant attendanceStreamGrant
+	if err := json.Unmarshal([]byte(payload), &grant); err != nil {
+		return nil, fmt.Errorf("failed to parse stream grant, %v", err)
+	}
+	return &grant, nil
+}
+
+// StreamAttendance streams attendance updates for an event as server-sent events. EventSource cannot
+// set headers, so the stream is opened with a stream ticket in the ticket query parameter, and the role
+// of the user the ticket was issued to is checked again.
+func (s *APIService) StreamAttendance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	eventID := mux.Vars(r)["eventId"]
+	logger := log.Ctx(ctx).With().Str("op", "StreamAttendance").Str("eventID", eventID).Logger()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	grant, err := s.redeemAttendanceStreamTicket(ctx, r.URL.Query().Get("ticket"))
+	if err != nil {
+		logger.Err(err).Msg("Failed to redeem stream ticket")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if grant == nil || grant.EventID != eventID {
+		errMsg := "Invalid or expired stream ticket"
+		logger.Info().Msg(errMsg)
+		http.Error(w, errMsg, http.StatusUnauthorized)
+		return
+	}
+	ctx = util.SetUserIDInContext(ctx, grant.UserID)
+	ctx = util.SetUserEmailInContext(ctx, grant.Email)
+	logger = logger.With().Str("email", grant.Email).Logger()
+	ctx = logger.WithContext(ctx)
+	_, rej, err := s.authorizeEvent(ctx, eventID, roleScanner)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if rej != nil {
+		http.Error(w, rej.reason, rej.status)
+		return
+	}
+
+	// subscribe before counting so that no update is missed in between
+	pubsub := s.redisClient.Subscribe(ctx, attendanceChannel(eventID))
+	defer pubsub.Close()
+	if _, err := pubsub.Receive(ctx); err != nil {
+		logger.Err(err).Msg("Failed to subscribe to live updates")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	count, err := s.dbClient.Attendances.CountByEventId(ctx, eventID)
+	if err != nil {
+		logger.Err(err).Msg("Failed to count attendance")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	snapshot, err := json.Marshal(attendanceUpdate{Type: attendanceUpdateSnapshot, CheckIns: count, At: time.Now()})
+	if err != nil {
+		logger.Err(err).Msg("Failed to marshal snapshot")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "data: %s\n\n", snapshot)
+	flusher.Flush()
+
+	logger.Info().Msg("Streaming attendance")
+
+	// comments keep idle connections from being closed by proxies
+	heartbeat := time.NewTicker(attendanceStreamHeartbeatInterval)
+	defer heartbeat.Stop()
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "data: %s\n\n", msg.Payload)
+		case <-heartbeat.C:
+			fmt.Fprint(w, ": heartbeat\n\n")
+		}
+		flusher.Flush()
+	}
+}
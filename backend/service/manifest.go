@@ -0,0 +1,64 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+)
+
+// eventManifest holds everything a scanner needs to verify check-in proofs for an event without
+// reaching the backend
+type eventManifest struct {
+	EventID           string    `json:"event_id"`
+	ContextID         string    `json:"context_id"`
+	ExternalNullifier string    `json:"external_nullifier"`
+	ChainID           string    `json:"chain_id"`
+	IssuerKeyIDs      []string  `json:"issuer_key_ids"`
+	CredentialTypeID  string    `json:"credential_type_id"`
+	VerificationKey   string    `json:"verification_key"`
+	StartDate         time.Time `json:"start_date"`
+	EndDate           time.Time `json:"end_date"`
+	AllowReentry      bool      `json:"allow_reentry"`
+	RevocationCheck   bool      `json:"revocation_check"`
+	Closed            bool      `json:"closed"`
+}
+
+// versionedManifest is the payload of a signed manifest
+type versionedManifest struct {
+	eventManifest
+	Version string `json:"version"`
+}
+
+// newEventManifest builds the manifest of an event, the issuer keys accepted for every event are
+// listed after the event's own key
+func newEventManifest(event events.Event, allowedIssuerKeyIDs []string) eventManifest {
+	issuerKeyIDs := append([]string{event.IssuerKeyID}, allowedIssuerKeyIDs...)
+	return eventManifest{
+		EventID:           event.ID,
+		ContextID:         event.ContextID,
+		ExternalNullifier: eventExternalNullifier(event),
+		ChainID:           event.ChainID,
+		IssuerKeyIDs:      issuerKeyIDs,
+		CredentialTypeID:  event.TicketTypeID,
+		VerificationKey:   event.VerificationKey,
+		StartDate:         event.StartDate.Time.UTC(),
+		EndDate:           event.EndDate.Time.UTC(),
+		AllowReentry:      event.AllowReentry,
+		RevocationCheck:   event.RevocationCheck,
+		Closed:            eventClosed(event),
+	}
+}
+
+// version returns a hash of the manifest content, so it changes whenever any field changes
+func (m eventManifest) version() (string, error) {
+	content, err := json.Marshal(m)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal manifest, %v", err)
+	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]), nil
+}
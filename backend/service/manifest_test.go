@@ -0,0 +1,51 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventManifestVersion(t *testing.T) {
+	event := events.Event{
+		ID:              "event",
+		ChainID:         "1",
+		ContextID:       "42",
+		IssuerKeyID:     "255",
+		StartDate:       pgtype.Timestamptz{Time: time.Unix(1700000000, 0), Valid: true},
+		EndDate:         pgtype.Timestamptz{Time: time.Unix(1700086400, 0), Valid: true},
+		VerificationKey: "{}",
+	}
+
+	manifest := newEventManifest(event, []string{"256"})
+	assert.Equal(t, []string{"255", "256"}, manifest.IssuerKeyIDs)
+
+	version, err := manifest.version()
+	assert.NoError(t, err)
+	sameVersion, err := newEventManifest(event, []string{"256"}).version()
+	assert.NoError(t, err)
+	assert.Equal(t, version, sameVersion)
+
+	// the name is not part of the manifest
+	event.Name = "renamed"
+	sameVersion, err = newEventManifest(event, []string{"256"}).version()
+	assert.NoError(t, err)
+	assert.Equal(t, version, sameVersion)
+
+	event.AllowReentry = true
+	newVersion, err := newEventManifest(event, []string{"256"}).version()
+	assert.NoError(t, err)
+	assert.NotEqual(t, version, newVersion)
+
+	// scanners learn that the event was cancelled with a new version
+	assert.False(t, newEventManifest(event, nil).Closed)
+	event.Status = eventStatusCancelled
+	closedManifest := newEventManifest(event, []string{"256"})
+	assert.True(t, closedManifest.Closed)
+	closedVersion, err := closedManifest.version()
+	assert.NoError(t, err)
+	assert.NotEqual(t, newVersion, closedVersion)
+}
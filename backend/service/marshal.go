@@ -1,24 +1,72 @@
 package service
 
 import (
+	"fmt"
+
+	"github.com/proof-pass/proof-pass/backend/csvimport"
 	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/repos/attendances"
 	"github.com/proof-pass/proof-pass/backend/repos/email_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/event_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/event_integrations"
 	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/issued_tickets"
+	"github.com/proof-pass/proof-pass/backend/repos/organization_members"
+	"github.com/proof-pass/proof-pass/backend/repos/organizations"
+	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+	"github.com/proof-pass/proof-pass/backend/repos/scanners"
 	"github.com/proof-pass/proof-pass/backend/repos/ticket_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_issuance_jobs"
 	"github.com/proof-pass/proof-pass/backend/repos/users"
+	"github.com/proof-pass/proof-pass/backend/repos/waitlist"
 )
 
+func MarshalCredentialType(credentialType *credentialType) openapi.CredentialType {
+	claims := make([]openapi.ClaimSchema, len(credentialType.claims))
+	for i, claim := range credentialType.claims {
+		claims[i] = openapi.ClaimSchema{
+			Name:        claim.Name,
+			Type:        claim.Type,
+			Width:       int32(claim.Width),
+			EqualChecks: int32(claim.EqualChecks),
+		}
+	}
+	return openapi.CredentialType{
+		TypeId:            credentialType.TypeID,
+		Name:              credentialType.Name,
+		OrganizationId:    credentialType.OrganizationID.String,
+		Claims:            claims,
+		Revocable:         credentialType.Revocable,
+		DefaultTtlSeconds: credentialType.DefaultTtlSeconds,
+	}
+}
+
 func MarshalEvent(event events.Event) openapi.Event {
 	return openapi.Event{
-		Id:          event.ID,
-		Name:        event.Name,
-		Description: event.Description,
-		Url:         event.Url,
-		ChainId:     event.ChainID,
-		ContextId:   event.ContextID,
-		IssuerKeyId: event.IssuerKeyID,
-		StartDate:   event.StartDate.Time,
-		EndDate:     event.EndDate.Time,
+		Id:                    event.ID,
+		Name:                  event.Name,
+		Description:           event.Description,
+		Url:                   event.Url,
+		ChainId:               event.ChainID,
+		ContextId:             event.ContextID,
+		ExternalNullifier:     eventExternalNullifier(event),
+		IssuerKeyId:           event.IssuerKeyID,
+		StartDate:             event.StartDate.Time,
+		EndDate:               event.EndDate.Time,
+		AllowReentry:          event.AllowReentry,
+		OrganizationId:        event.OrganizationID.String,
+		Capacity:              event.Capacity.Int32,
+		RegistrationMode:      event.RegistrationMode,
+		AllowedEmailDomains:   event.AllowedEmailDomains,
+		RegistrationDeadline:  registrationDeadline(event),
+		Status:                event.Status,
+		PublishAt:             event.PublishAt.Time,
+		TicketExpiryPolicy:    event.TicketExpiryPolicy,
+		TicketValiditySeconds: event.TicketValiditySeconds,
+		TieredTickets:         event.TieredTickets,
+		TicketTypeId:          event.TicketTypeID,
+		CredentialTypeIds:     event.CredentialTypeIds,
+		RevocationCheck:       event.RevocationCheck,
 	}
 }
 
@@ -70,3 +118,163 @@ func MarshalTicketCredentials(credentials []ticket_credentials.TicketCredential)
 	}
 	return marshaledCredentials
 }
+
+func MarshalAttendance(attendance attendances.Attendance) openapi.Attendance {
+	return openapi.Attendance{
+		EventId:       attendance.EventID,
+		ScanCount:     attendance.ScanCount,
+		CheckedInAt:   attendance.CreatedAt.Time,
+		LastScannedAt: attendance.LastScannedAt.Time,
+		ScannerId:     attendance.ScannerID.String,
+	}
+}
+
+func MarshalScanner(scanner scanners.Scanner) openapi.Scanner {
+	return openapi.Scanner{
+		Id:        scanner.ID,
+		EventId:   scanner.EventID,
+		Name:      scanner.Name,
+		Revoked:   scanner.RevokedAt.Valid,
+		CreatedAt: scanner.CreatedAt.Time,
+	}
+}
+
+func MarshalHourlyCheckIns(rows []attendances.GetHourlyCheckInsByEventIdRow) []openapi.HourlyCheckIns {
+	marshaledRows := make([]openapi.HourlyCheckIns, len(rows))
+	for i, row := range rows {
+		marshaledRows[i] = openapi.HourlyCheckIns{
+			Hour:     row.Hour.Time,
+			CheckIns: row.CheckIns,
+		}
+	}
+	return marshaledRows
+}
+
+func MarshalRegistrationImportReport(report csvimport.Report) openapi.RegistrationImportReport {
+	invalid := make([]openapi.InvalidRegistrationRow, len(report.Invalid))
+	for i, row := range report.Invalid {
+		invalid[i] = openapi.InvalidRegistrationRow{
+			Line:   int32(row.Line),
+			Value:  row.Value,
+			Reason: row.Reason,
+		}
+	}
+	return openapi.RegistrationImportReport{
+		Added:               int32(report.Added),
+		Unchanged:           int32(report.Unchanged),
+		Removed:             int32(report.Removed),
+		Revoked:             int32(report.Revoked),
+		Waitlisted:          int32(report.Waitlisted),
+		RemovedFromWaitlist: int32(report.RemovedFromWaitlist),
+		Invalid:             invalid,
+	}
+}
+
+func MarshalEventCredential(credential event_credentials.EventCredential) openapi.EventCredential {
+	return openapi.EventCredential{
+		EventId:    credential.EventID,
+		TypeId:     credential.TypeID,
+		Credential: credential.Credential.String,
+		IssuedAt:   credential.IssuedAt.Time,
+		ExpireAt:   credential.ExpireAt.Time,
+	}
+}
+
+func MarshalEventCredentials(credentials []event_credentials.EventCredential) []openapi.EventCredential {
+	marshaledCredentials := make([]openapi.EventCredential, len(credentials))
+	for i, credential := range credentials {
+		marshaledCredentials[i] = MarshalEventCredential(credential)
+	}
+	return marshaledCredentials
+}
+
+func MarshalEventIntegration(integration event_integrations.EventIntegration) openapi.EventIntegration {
+	return openapi.EventIntegration{
+		Id:              integration.ID,
+		EventId:         integration.EventID,
+		Provider:        integration.Provider,
+		ExternalEventId: integration.ExternalEventID,
+		WebhookPath:     fmt.Sprintf("/v1/webhooks/%s/%s", integration.Provider, integration.ID),
+		Secret:          integration.Secret,
+		CreatedAt:       integration.CreatedAt.Time,
+	}
+}
+
+func MarshalEventIntegrations(integrations []event_integrations.EventIntegration) []openapi.EventIntegration {
+	marshaledIntegrations := make([]openapi.EventIntegration, len(integrations))
+	for i, integration := range integrations {
+		marshaledIntegrations[i] = MarshalEventIntegration(integration)
+	}
+	return marshaledIntegrations
+}
+
+// MarshalOrganization includes the role of the signed in user in the organization
+func MarshalOrganization(organization organizations.Organization, role string) openapi.Organization {
+	return openapi.Organization{
+		Id:        organization.ID,
+		Name:      organization.Name,
+		Role:      role,
+		CreatedAt: organization.CreatedAt.Time,
+	}
+}
+
+func MarshalOrganizationMember(member organization_members.OrganizationMember, email string) openapi.OrganizationMember {
+	return openapi.OrganizationMember{
+		UserId:    member.UserID,
+		Email:     email,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt.Time,
+	}
+}
+
+func MarshalRevokedTicket(ticket issued_tickets.IssuedTicket) openapi.RevokedTicket {
+	return openapi.RevokedTicket{
+		CredentialId: ticket.CredentialID,
+		RevokedAt:    ticket.RevokedAt.Time,
+	}
+}
+
+func MarshalRevokedTickets(tickets []issued_tickets.IssuedTicket) []openapi.RevokedTicket {
+	marshaledTickets := make([]openapi.RevokedTicket, len(tickets))
+	for i, ticket := range tickets {
+		marshaledTickets[i] = MarshalRevokedTicket(ticket)
+	}
+	return marshaledTickets
+}
+
+func MarshalRegistration(registration registrations.Registration) openapi.Registration {
+	return openapi.Registration{
+		EventId: registration.EventID,
+		Email:   registration.Email,
+		Tier:    registration.Tier,
+	}
+}
+
+// MarshalWaitlist numbers the entries from 1, they must be in waitlist order
+func MarshalWaitlist(entries []waitlist.Waitlist) []openapi.WaitlistEntry {
+	marshaledEntries := make([]openapi.WaitlistEntry, len(entries))
+	for i, entry := range entries {
+		marshaledEntries[i] = openapi.WaitlistEntry{
+			Email:     entry.Email,
+			Position:  int32(i + 1),
+			CreatedAt: entry.CreatedAt.Time,
+		}
+	}
+	return marshaledEntries
+}
+
+func MarshalTicketIssuanceJob(job ticket_issuance_jobs.TicketIssuanceJob) openapi.TicketIssuanceJob {
+	return openapi.TicketIssuanceJob{
+		Id:          job.ID,
+		EventId:     job.EventID,
+		Status:      job.Status,
+		Total:       job.Total,
+		Issued:      job.Issued,
+		Skipped:     job.Skipped,
+		Failed:      job.Failed,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt.Time,
+		UpdatedAt:   job.UpdatedAt.Time,
+		CompletedAt: job.CompletedAt.Time,
+	}
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errInvalidProof = errors.New("invalid proof")
+
+// verifiedProof holds the public signals of a proof that has been verified by the issuer
+type verifiedProof struct {
+	Type      string
+	Context   string
+	Nullifier string
+	// ExternalNullifier scopes the nullifier, a holder gets a different nullifier for each external nullifier
+	ExternalNullifier string
+	KeyID             string
+	ExpirationLb      time.Time
+	// CredentialID is the ID of the credential revealed by the proof, "0" if it was not revealed
+	CredentialID string
+	// ClaimSignals are the signals of the claim conditions, in the order of the claims of the credential type
+	ClaimSignals []string
+}
+
+// verifyProof verifies the proof against the verification key and returns its public signals.
+// errInvalidProof is returned if the proof does not verify.
+func (s *APIService) verifyProof(ctx context.Context, verificationKey string, proof string, publicSignals []string) (*verifiedProof, error) {
+	// the proof comes from the holder, a malformed one is invalid and never reaches the issuer
+	if !json.Valid([]byte(proof)) {
+		return nil, errInvalidProof
+	}
+	resp, err := s.issuerClient.VerifyProof(ctx, &issuer.VerifyProofRequest{
+		Proof:           proof,
+		PublicSignals:   publicSignals,
+		VerificationKey: verificationKey,
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, errInvalidProof
+		}
+		return nil, fmt.Errorf("failed to verify proof, %v", err)
+	}
+	if !resp.GetValid() {
+		return nil, errInvalidProof
+	}
+
+	expirationLb, err := parseUnixTimestamp(resp.GetExpirationLb())
+	if err != nil {
+		return nil, errInvalidProof
+	}
+
+	return &verifiedProof{
+		Type:              resp.GetType(),
+		Context:           resp.GetContext(),
+		Nullifier:         resp.GetNullifier(),
+		ExternalNullifier: resp.GetExternalNullifier(),
+		KeyID:             resp.GetKeyId(),
+		ExpirationLb:      expirationLb,
+		CredentialID:      resp.GetIdEqualsTo(),
+		ClaimSignals:      resp.GetClaimSignals(),
+	}, nil
+}
+
+// eventExternalNullifier returns the external nullifier check-in proofs of the event are generated with.
+// It is fixed by the event, so each ticket has a single nullifier at the event and cannot check in twice.
+func eventExternalNullifier(event events.Event) string {
+	return util.StringToUint248Hash("checkin:" + event.ID).String()
+}
+
+// parseUnixTimestamp parses a decimal unix timestamp in seconds
+func parseUnixTimestamp(value string) (time.Time, error) {
+	sec, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(sec, 0), nil
+}
+
+// matchesIssuerKey reports whether keyID equals one of the allowed key IDs.
+// Key IDs are compared as numbers so decimal and 0x-prefixed hex forms match.
+func matchesIssuerKey(keyID string, allowed ...string) bool {
+	key, ok := new(big.Int).SetString(keyID, 0)
+	if !ok {
+		return false
+	}
+	for _, allowedKeyID := range allowed {
+		allowedKey, ok := new(big.Int).SetString(allowedKeyID, 0)
+		if ok && key.Cmp(allowedKey) == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeIssuerClient struct {
+	issuer.IssuerServiceClient
+	verifyProofResp *issuer.VerifyProofResponse
+	verifyProofErr  error
+}
+
+func (c *fakeIssuerClient) VerifyProof(ctx context.Context, in *issuer.VerifyProofRequest, opts ...grpc.CallOption) (*issuer.VerifyProofResponse, error) {
+	return c.verifyProofResp, c.verifyProofErr
+}
+
+func TestVerifyProof(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	apiService := &APIService{issuerClient: &fakeIssuerClient{
+		verifyProofResp: &issuer.VerifyProofResponse{
+			Valid:             true,
+			Type:              "1",
+			Context:           "42",
+			Nullifier:         "123",
+			ExternalNullifier: "321",
+			KeyId:             "456",
+			ExpirationLb:      strconv.FormatInt(expiration.Unix(), 10),
+			IdEqualsTo:        "789",
+			ClaimSignals:      []string{"2", "3"},
+		},
+	}}
+
+	proof, err := apiService.verifyProof(context.Background(), "{}", "{}", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "1", proof.Type)
+	assert.Equal(t, "42", proof.Context)
+	assert.Equal(t, "123", proof.Nullifier)
+	assert.Equal(t, "321", proof.ExternalNullifier)
+	assert.Equal(t, "456", proof.KeyID)
+	assert.Equal(t, "789", proof.CredentialID)
+	assert.Equal(t, []string{"2", "3"}, proof.ClaimSignals)
+	assert.True(t, expiration.Equal(proof.ExpirationLb))
+}
+
+func TestVerifyProof_Invalid(t *testing.T) {
+	apiService := &APIService{issuerClient: &fakeIssuerClient{
+		verifyProofResp: &issuer.VerifyProofResponse{Valid: false},
+	}}
+
+	_, err := apiService.verifyProof(context.Background(), "{}", "{}", nil)
+	assert.ErrorIs(t, err, errInvalidProof)
+}
+
+func TestVerifyProof_Malformed(t *testing.T) {
+	apiService := &APIService{issuerClient: &fakeIssuerClient{
+		verifyProofResp: &issuer.VerifyProofResponse{Valid: true},
+	}}
+
+	_, err := apiService.verifyProof(context.Background(), "{}", "not json", nil)
+	assert.ErrorIs(t, err, errInvalidProof)
+
+	// the issuer rejects proofs it cannot parse
+	apiService = &APIService{issuerClient: &fakeIssuerClient{
+		verifyProofErr: status.Error(codes.InvalidArgument, "malformed proof"),
+	}}
+	_, err = apiService.verifyProof(context.Background(), "{}", "{}", nil)
+	assert.ErrorIs(t, err, errInvalidProof)
+
+	// other issuer errors are not the holder's fault
+	apiService = &APIService{issuerClient: &fakeIssuerClient{
+		verifyProofErr: status.Error(codes.Unavailable, "issuer unavailable"),
+	}}
+	_, err = apiService.verifyProof(context.Background(), "{}", "{}", nil)
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, errInvalidProof)
+}
+
+func TestVerifyProof_InvalidExpiration(t *testing.T) {
+	apiService := &APIService{issuerClient: &fakeIssuerClient{
+		verifyProofResp: &issuer.VerifyProofResponse{Valid: true, ExpirationLb: "not a number"},
+	}}
+
+	_, err := apiService.verifyProof(context.Background(), "{}", "{}", nil)
+	assert.ErrorIs(t, err, errInvalidProof)
+}
+
+func TestMatchesIssuerKey(t *testing.T) {
+	assert.True(t, matchesIssuerKey("255", "255"))
+	assert.True(t, matchesIssuerKey("255", "1", "0xff"))
+	assert.False(t, matchesIssuerKey("255", "256"))
+	assert.False(t, matchesIssuerKey("255", ""))
+	assert.False(t, matchesIssuerKey("", ""))
+}
+
+func TestVerifyAttendanceProof_ExternalNullifier(t *testing.T) {
+	event := events.Event{ID: "event", ContextID: "42", TicketTypeID: "1", VerificationKey: "{}", Status: eventStatusPublished}
+	resp := &issuer.VerifyProofResponse{
+		Valid:        true,
+		Type:         "1",
+		Context:      "42",
+		Nullifier:    "123",
+		ExpirationLb: strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+	}
+	apiService := &APIService{issuerClient: &fakeIssuerClient{verifyProofResp: resp}}
+
+	// only tickets of the ticket type of the event are accepted
+	resp.Type = "2"
+	_, rej, err := apiService.verifyAttendanceProof(context.Background(), event, "{}", nil, "")
+	require.NoError(t, err)
+	require.NotNil(t, rej)
+	assert.Equal(t, "Invalid credential type", rej.reason)
+	resp.Type = "1"
+
+	// a proof for another external nullifier would give the same ticket another nullifier
+	resp.ExternalNullifier = eventExternalNullifier(events.Event{ID: "other"})
+	_, rej, err = apiService.verifyAttendanceProof(context.Background(), event, "{}", nil, "")
+	require.NoError(t, err)
+	require.NotNil(t, rej)
+	assert.Equal(t, "Invalid external nullifier", rej.reason)
+
+	assert.NotEqual(t, eventExternalNullifier(event), eventExternalNullifier(events.Event{ID: "other"}))
+}
+
+func TestVerifyAttendanceProof_RevocationCheck(t *testing.T) {
+	event := events.Event{ID: "event", ContextID: "42", TicketTypeID: "1", IssuerKeyID: "255", VerificationKey: "{}", Status: eventStatusPublished}
+	resp := &issuer.VerifyProofResponse{
+		Valid:             true,
+		Type:              "1",
+		Context:           "42",
+		Nullifier:         "123",
+		ExternalNullifier: eventExternalNullifier(event),
+		KeyId:             "255",
+		ExpirationLb:      strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+	}
+	apiService := &APIService{issuerClient: &fakeIssuerClient{verifyProofResp: resp}}
+
+	// tickets stay anonymous at events that do not check revocation
+	proof, rej, err := apiService.verifyAttendanceProof(context.Background(), event, "{}", nil, "")
+	require.NoError(t, err)
+	require.Nil(t, rej)
+	assert.Equal(t, "123", proof.Nullifier)
+
+	// events checking revocation need the ticket ID
+	event.RevocationCheck = true
+	_, rej, err = apiService.verifyAttendanceProof(context.Background(), event, "{}", nil, "")
+	require.NoError(t, err)
+	require.NotNil(t, rej)
+	assert.Equal(t, "Credential ID not revealed, cannot check revocation", rej.reason)
+}
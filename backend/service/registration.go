@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/proof-pass/proof-pass/backend/repos/event_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/issued_tickets"
+	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_credentials"
+	"github.com/rs/zerolog/log"
+)
+
+// cancelRegistration removes the registration of the email, deletes the tickets stored for the attendee
+// or pre-issued to them and revokes the tickets issued to the email. The freed place goes to the next
+// person on the waitlist.
+// It reports false if the email is not registered for the event, in which case nothing is changed.
+func (s *APIService) cancelRegistration(ctx context.Context, eventID string, email string, reason string) (bool, error) {
+	tx, err := s.dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction, %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	deleted, err := s.dbClient.Registrations.WithTx(tx).DeleteOneByEventIdAndEmail(ctx, registrations.DeleteOneByEventIdAndEmailParams{
+		EventID: eventID,
+		Email:   email,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete registration, %v", err)
+	}
+	if deleted == 0 {
+		return false, nil
+	}
+	if _, err := s.dbClient.TicketCredentials.WithTx(tx).DeleteByEventIdAndEmails(ctx, ticket_credentials.DeleteByEventIdAndEmailsParams{
+		EventID: eventID,
+		Emails:  []string{email},
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete ticket credential, %v", err)
+	}
+	if _, err := s.dbClient.EventCredentials.WithTx(tx).DeletePreIssuedByEventIdAndEmails(ctx, event_credentials.DeletePreIssuedByEventIdAndEmailsParams{
+		EventID: eventID,
+		Emails:  []string{email},
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete pre-issued tickets, %v", err)
+	}
+	if _, err := s.dbClient.IssuedTickets.WithTx(tx).RevokeByEventIdAndEmails(ctx, issued_tickets.RevokeByEventIdAndEmailsParams{
+		EventID:          eventID,
+		Emails:           []string{email},
+		RevocationReason: reason,
+	}); err != nil {
+		return false, fmt.Errorf("failed to revoke tickets, %v", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit cancellation, %v", err)
+	}
+
+	// the cancellation stands if the place cannot be given out now, it is given out on the next cancellation
+	if _, err := s.fillFromWaitlist(ctx, eventID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("eventID", eventID).Msg("Failed to fill freed place from the waitlist")
+	}
+	return true, nil
+}
+
+// hasTicket reports whether the email has a ticket for the event, stored by the attendee, pre-issued to
+// them or issued to them and not revoked
+func (s *APIService) hasTicket(ctx context.Context, event events.Event, email string) (bool, error) {
+	_, err := s.dbClient.TicketCredentials.GetByEventIdAndEmail(ctx, ticket_credentials.GetByEventIdAndEmailParams{
+		EventID: event.ID,
+		Email:   email,
+	})
+	if err == nil {
+		return true, nil
+	}
+	if err != pgx.ErrNoRows {
+		return false, fmt.Errorf("failed to get ticket credential, %v", err)
+	}
+	_, err = s.dbClient.EventCredentials.GetIssuedByEventIdEmailAndTypeId(ctx, event_credentials.GetIssuedByEventIdEmailAndTypeIdParams{
+		EventID: event.ID,
+		Email:   email,
+		TypeID:  event.TicketTypeID,
+	})
+	if err == nil {
+		return true, nil
+	}
+	if err != pgx.ErrNoRows {
+		return false, fmt.Errorf("failed to get pre-issued ticket, %v", err)
+	}
+	issued, err := s.dbClient.IssuedTickets.CountActiveByEventIdAndEmail(ctx, issued_tickets.CountActiveByEventIdAndEmailParams{
+		EventID: event.ID,
+		Email:   email,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to count issued tickets, %v", err)
+	}
+	return issued > 0, nil
+}
+
+// Registration modes of an event
+const (
+	// registrationModeInviteOnly events are registered by the organizer, by import or by a ticketing platform
+	registrationModeInviteOnly = "invite_only"
+	// registrationModeOpen events can be registered for by any signed in user
+	registrationModeOpen = "open"
+	// registrationModeDomain events can be registered for by users with an email of an allowed domain
+	registrationModeDomain = "domain"
+)
+
+// registrationModeOrDefault returns the mode, events are invite only unless set otherwise
+func registrationModeOrDefault(mode string) string {
+	if mode == "" {
+		return registrationModeInviteOnly
+	}
+	return mode
+}
+
+// normalizeEmailDomains lowercases the domains and removes a leading @ and repeated domains.
+// The result is never nil, as the column does not accept NULL.
+func normalizeEmailDomains(domains []string) []string {
+	normalized := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(domain), "@"))
+		if !slices.Contains(normalized, domain) {
+			normalized = append(normalized, domain)
+		}
+	}
+	return normalized
+}
+
+// validEmailDomain reports whether the normalized domain can be the domain of an email address
+func validEmailDomain(domain string) bool {
+	address, err := mail.ParseAddress("user@" + domain)
+	return err == nil && address.Address == "user@"+domain && strings.Contains(domain, ".")
+}
+
+// emailDomain returns the lowercased domain of the email
+func emailDomain(email string) string {
+	_, domain, _ := strings.Cut(email, "@")
+	return strings.ToLower(domain)
+}
+
+// registrationDeadline returns when registration closes, the end of the event if no deadline was set
+func registrationDeadline(event events.Event) time.Time {
+	if event.RegistrationDeadline.Valid {
+		return event.RegistrationDeadline.Time
+	}
+	return event.EndDate.Time
+}
+
+// registrationClosed reports whether the deadline has passed, for self registration and imports alike
+func registrationClosed(event events.Event, now time.Time) bool {
+	return !now.Before(registrationDeadline(event))
+}
+
+// checkSelfRegistration returns why the user cannot register themselves for the event, or nil if they can.
+// Invite only events are only registered by the organizer.
+func checkSelfRegistration(event events.Event, email string, now time.Time) *rejection {
+	if eventClosed(event) {
+		return &rejection{http.StatusBadRequest, fmt.Sprintf("Event is %s", event.Status)}
+	}
+	if !eventPublished(event, now) {
+		return &rejection{http.StatusNotFound, "Event not found"}
+	}
+	if registrationClosed(event, now) {
+		return &rejection{http.StatusBadRequest, "Registration has closed"}
+	}
+	if event.RegistrationMode != registrationModeOpen && event.RegistrationMode != registrationModeDomain {
+		return &rejection{http.StatusForbidden, "Event is invite only"}
+	}
+	if !emailDomainAllowed(event, email) {
+		return &rejection{http.StatusForbidden, "Registration is restricted to emails of the allowed domains"}
+	}
+	return nil
+}
+
+// emailDomainAllowed reports whether the email is of one of the allowed domains of the event.
+// Every email is allowed if the event lists no domain, domain events always list one.
+func emailDomainAllowed(event events.Event, email string) bool {
+	return len(event.AllowedEmailDomains) == 0 || slices.Contains(event.AllowedEmailDomains, emailDomain(email))
+}
+
+// Ticket tiers of a registration, each tier is let in wherever the tiers below it are
+const (
+	ticketTierGeneral = "general"
+	ticketTierVIP     = "vip"
+	ticketTierSpeaker = "speaker"
+)
+
+var ticketTierRanks = map[string]int{
+	ticketTierGeneral: 1,
+	ticketTierVIP:     2,
+	ticketTierSpeaker: 3,
+}
+
+// validTicketTier reports whether tier is one of the ticket tiers
+func validTicketTier(tier string) bool {
+	_, ok := ticketTierRanks[tier]
+	return ok
+}
+
+// ticketTierValue returns the value of the claim of tiered tickets, the rank of the tier
+func ticketTierValue(tier string) string {
+	return fmt.Sprint(ticketTierRanks[tier])
+}
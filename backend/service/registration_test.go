@@ -0,0 +1,53 @@
+package service
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSelfRegistration(t *testing.T) {
+	now := time.Now()
+	open := events.Event{
+		Status:           eventStatusPublished,
+		RegistrationMode: registrationModeOpen,
+		EndDate:          pgtype.Timestamptz{Time: now.Add(24 * time.Hour), Valid: true},
+	}
+	assert.Nil(t, checkSelfRegistration(open, "alice@example.com", now))
+
+	inviteOnly := open
+	inviteOnly.RegistrationMode = registrationModeInviteOnly
+	assert.Equal(t, http.StatusForbidden, checkSelfRegistration(inviteOnly, "alice@example.com", now).status)
+
+	domain := open
+	domain.RegistrationMode = registrationModeDomain
+	domain.AllowedEmailDomains = normalizeEmailDomains([]string{"@Example.com "})
+	assert.Nil(t, checkSelfRegistration(domain, "alice@EXAMPLE.com", now))
+	assert.Equal(t, http.StatusForbidden, checkSelfRegistration(domain, "alice@example.org", now).status)
+	assert.Equal(t, http.StatusForbidden, checkSelfRegistration(domain, "alice@sub.example.com", now).status)
+
+	// registration closes at the deadline, or at the end of the event without one
+	assert.Equal(t, http.StatusBadRequest, checkSelfRegistration(open, "alice@example.com", now.Add(25*time.Hour)).status)
+	withDeadline := open
+	withDeadline.RegistrationDeadline = pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true}
+	assert.Nil(t, checkSelfRegistration(withDeadline, "alice@example.com", now))
+	assert.Equal(t, http.StatusBadRequest, checkSelfRegistration(withDeadline, "alice@example.com", now.Add(2*time.Hour)).status)
+
+	// the domain rule also holds for invite only events, whose registrations are imported
+	inviteOnly.AllowedEmailDomains = []string{"example.com"}
+	assert.True(t, emailDomainAllowed(inviteOnly, "alice@example.com"))
+	assert.False(t, emailDomainAllowed(inviteOnly, "alice@example.org"))
+	assert.True(t, emailDomainAllowed(open, "alice@example.org"))
+
+	// only published events take registrations
+	draft := open
+	draft.Status = eventStatusDraft
+	assert.Equal(t, http.StatusNotFound, checkSelfRegistration(draft, "alice@example.com", now).status)
+	cancelled := open
+	cancelled.Status = eventStatusCancelled
+	assert.Equal(t, http.StatusBadRequest, checkSelfRegistration(cancelled, "alice@example.com", now).status)
+}
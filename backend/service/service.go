@@ -2,9 +2,15 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/mail"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/ses"
@@ -12,40 +18,78 @@ import (
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/csvimport"
 	"github.com/proof-pass/proof-pass/backend/jwt"
 	"github.com/proof-pass/proof-pass/backend/openapi"
 	"github.com/proof-pass/proof-pass/backend/repos"
-	"github.com/proof-pass/proof-pass/backend/repos/attendances"
+	"github.com/proof-pass/proof-pass/backend/repos/credential_types"
 	"github.com/proof-pass/proof-pass/backend/repos/email_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/event_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/event_integrations"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/issued_tickets"
+	"github.com/proof-pass/proof-pass/backend/repos/offline_scans"
+	"github.com/proof-pass/proof-pass/backend/repos/organization_members"
+	"github.com/proof-pass/proof-pass/backend/repos/organizations"
 	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+	"github.com/proof-pass/proof-pass/backend/repos/scanners"
 	"github.com/proof-pass/proof-pass/backend/repos/ticket_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_issuance_jobs"
 	"github.com/proof-pass/proof-pass/backend/repos/users"
+	"github.com/proof-pass/proof-pass/backend/repos/waitlist"
 	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/proof-pass/proof-pass/backend/webhook"
 	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
 	"github.com/rs/zerolog/log"
 )
 
 const (
 	emailSigninCodeCacheDurationSec = 60
-	unitCredentialTypeID            = 1
-	ticketCredentialValidDuration   = time.Hour * 24 * 265
 	emailCredentialValidDuration    = time.Hour * 24 * 14
+	maxAttendanceBatchSize          = 500
+)
+
+const (
+	batchAttendanceStatusAccepted  = "accepted"
+	batchAttendanceStatusDuplicate = "duplicate"
+	batchAttendanceStatusInvalid   = "invalid"
+)
+
+const (
+	waitlistStatusRegistered = "registered"
+	waitlistStatusWaitlisted = "waitlisted"
+)
+
+// reasons recorded with revoked tickets
+const (
+	revocationReasonOrganizer            = "revoked by organizer"
+	revocationReasonCancelled            = "registration cancelled"
+	revocationReasonCancelledByOrganizer = "registration cancelled by organizer"
+	revocationReasonNotStored            = "ticket could not be stored"
 )
 
 type APIService struct {
-	emailCredentialContextID int64
-	issuerChainID            int64
-	dbClient                 *repos.Client
-	redisClient              redis.UniversalClient
-	sesClient                *ses.Client // null if email login is disabled
-	jwtService               *jwt.Service
-	issuerClient             issuer.IssuerServiceClient
+	emailCredentialContextID    int64
+	emailCredentialTypeID       string
+	emailDomainCredentialTypeID string
+	issuerChainID               int64
+	allowedIssuerKeyIDs         []string
+	adminEmails                 []string
+	dbClient                    *repos.Client
+	redisClient                 redis.UniversalClient
+	sesClient                   *ses.Client // null if email login is disabled
+	jwtService                  *jwt.Service
+	issuerClient                issuer.IssuerServiceClient
 }
 
 // NewAPIService creates a default api service
 func NewAPIService(
 	emailCredentialContextID int64,
+	emailCredentialTypeID string,
+	emailDomainCredentialTypeID string,
 	issuerChainID int64,
+	allowedIssuerKeyIDs []string,
+	adminEmails []string,
 	dbClient *repos.Client,
 	redisClient redis.UniversalClient,
 	sesClient *ses.Client,
@@ -53,203 +97,1807 @@ func NewAPIService(
 	issuerClient issuer.IssuerServiceClient,
 ) *APIService {
 	return &APIService{
-		emailCredentialContextID: emailCredentialContextID,
-		issuerChainID:            issuerChainID,
-		dbClient:                 dbClient,
-		redisClient:              redisClient,
-		sesClient:                sesClient,
-		jwtService:               jwtService,
-		issuerClient:             issuerClient,
+		emailCredentialContextID:    emailCredentialContextID,
+		emailCredentialTypeID:       emailCredentialTypeID,
+		emailDomainCredentialTypeID: emailDomainCredentialTypeID,
+		issuerChainID:               issuerChainID,
+		allowedIssuerKeyIDs:         allowedIssuerKeyIDs,
+		adminEmails:                 adminEmails,
+		dbClient:                    dbClient,
+		redisClient:                 redisClient,
+		sesClient:                   sesClient,
+		jwtService:                  jwtService,
+		issuerClient:                issuerClient,
+	}
+}
+
+// EventsEventIdAttendancePost - Record attendance for an event
+func (s *APIService) EventsEventIdAttendancePost(ctx context.Context, eventId string, recordAttendanceRequest openapi.RecordAttendanceRequest) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdAttendancePost").Str("eventID", eventId).Logger()
+	ctx = logger.WithContext(ctx)
+
+	// validate scanner, each device has its own revocable token scoped to one event
+	scanner, rej, err := s.authorizeScanner(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize scanner")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+	logger = logger.With().Str("scannerID", scanner.ID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Err(err).Msg("Event not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	proof, rej, err := s.verifyAttendanceProof(ctx, event, recordAttendanceRequest.Proof, recordAttendanceRequest.PublicSignals, recordAttendanceRequest.MinTier)
+	if err != nil {
+		logger.Err(err).Msg("Failed to verify proof")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		s.publishAttendanceUpdate(ctx, eventId, attendanceUpdate{Type: attendanceUpdateRejected, ScannerID: scanner.ID, Reason: rej.reason})
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+	nullifier := proof.Nullifier
+
+	attendance, recorded, err := recordAttendance(ctx, s.dbClient.Attendances, event, nullifier, scanner.ID, time.Now())
+	if err != nil {
+		logger.Err(err).Msg("Failed to record attendance")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if !recorded {
+		// the nullifier has already been used for this event
+		errMsg := "Attendance already recorded"
+		logger.Info().Str("nullifier", nullifier).Int32("attendance", attendance.ID).Msg(errMsg)
+		s.publishAttendanceUpdate(ctx, eventId, attendanceUpdate{Type: attendanceUpdateRejected, ScannerID: scanner.ID, Reason: errMsg})
+		return openapi.Response(http.StatusConflict, MarshalAttendance(attendance)), nil
+	}
+
+	logger.Info().Str("nullifier", nullifier).Int32("attendance", attendance.ID).Int32("scanCount", attendance.ScanCount).Msg("Recorded attendance")
+
+	update := attendanceUpdate{Type: attendanceUpdateCheckIn, ScannerID: scanner.ID}
+	if attendance.ScanCount > 1 {
+		update.Type = attendanceUpdateReentry
+	}
+	s.publishAttendanceUpdate(ctx, eventId, update)
+
+	return openapi.Response(http.StatusCreated, MarshalAttendance(attendance)), nil
+}
+
+// EventsEventIdAttendanceBatchPost - Upload attendance recorded while offline
+func (s *APIService) EventsEventIdAttendanceBatchPost(ctx context.Context, eventId string, batchAttendanceRequest openapi.BatchAttendanceRequest) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdAttendanceBatchPost").Str("eventID", eventId).Logger()
+	ctx = logger.WithContext(ctx)
+
+	scanner, rej, err := s.authorizeScanner(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize scanner")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+	logger = logger.With().Str("scannerID", scanner.ID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	items := batchAttendanceRequest.Items
+	if len(items) == 0 || len(items) > maxAttendanceBatchSize {
+		errMsg := fmt.Sprintf("Batch must contain between 1 and %d items", maxAttendanceBatchSize)
+		logger.Info().Int("items", len(items)).Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Err(err).Msg("Event not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	// verify every proof before touching the database
+	type scan struct {
+		index     int
+		nullifier string
+		scannedAt time.Time
+		nonce     string
+	}
+	now := time.Now()
+	results := make([]openapi.BatchAttendanceResult, len(items))
+	scans := make([]scan, 0, len(items))
+	replayed := 0
+	for i, item := range items {
+		results[i].Index = int32(i)
+		// a retried upload gets the original result of the scans it already recorded
+		if item.Nonce != "" {
+			previous, err := s.dbClient.OfflineScans.GetByScannerIdAndNonce(ctx, offline_scans.GetByScannerIdAndNonceParams{
+				ScannerID: scanner.ID,
+				Nonce:     item.Nonce,
+			})
+			if err == nil {
+				results[i].Status = previous.Status
+				results[i].Nullifier = previous.Nullifier
+				replayed++
+				continue
+			}
+			if err != pgx.ErrNoRows {
+				logger.Err(err).Int("item", i).Msg("Failed to get uploaded scan")
+				return openapi.Response(http.StatusInternalServerError, nil), err
+			}
+		}
+		itemCtx := logger.With().Int("item", i).Logger().WithContext(ctx)
+		proof, rej, err := s.verifyAttendanceProof(itemCtx, event, item.Proof, item.PublicSignals, batchAttendanceRequest.MinTier)
+		if err != nil {
+			logger.Err(err).Int("item", i).Msg("Failed to verify proof")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		if rej != nil {
+			results[i].Status = batchAttendanceStatusInvalid
+			results[i].Reason = rej.reason
+			continue
+		}
+		results[i].Nullifier = proof.Nullifier
+		scans = append(scans, scan{i, proof.Nullifier, offlineScanTime(item.ScannedAt, now), item.Nonce})
+	}
+
+	// record in scan order so that re-entry scans are not mistaken for replays
+	sort.SliceStable(scans, func(a, b int) bool {
+		return scans[a].scannedAt.Before(scans[b].scannedAt)
+	})
+
+	tx, err := s.dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		logger.Err(err).Msg("Failed to begin transaction")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	defer tx.Rollback(ctx)
+	q := s.dbClient.Attendances.WithTx(tx)
+
+	for _, scan := range scans {
+		attendance, recorded, err := recordAttendance(ctx, q, event, scan.nullifier, scanner.ID, scan.scannedAt)
+		if err != nil {
+			logger.Err(err).Int("item", scan.index).Msg("Failed to record attendance")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		// a retried upload of scans without a nonce reports the scans it already recorded as accepted again
+		status := batchAttendanceStatusDuplicate
+		if recorded || isReplayedScan(attendance, event.AllowReentry, scanner.ID, scan.scannedAt) {
+			status = batchAttendanceStatusAccepted
+		}
+		if scan.nonce != "" {
+			status, err = recordOfflineScan(ctx, s.dbClient.OfflineScans.WithTx(tx), offline_scans.CreateOneParams{
+				ScannerID: scanner.ID,
+				Nonce:     scan.nonce,
+				EventID:   eventId,
+				Nullifier: scan.nullifier,
+				Status:    status,
+			})
+			if err != nil {
+				logger.Err(err).Int("item", scan.index).Msg("Failed to record uploaded scan")
+				return openapi.Response(http.StatusInternalServerError, nil), err
+			}
+		}
+		results[scan.index].Status = status
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		logger.Err(err).Msg("Failed to commit attendance batch")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	accepted := 0
+	for _, result := range results {
+		if result.Status == batchAttendanceStatusAccepted {
+			accepted++
+		}
+	}
+	logger.Info().Int("items", len(items)).Int("accepted", accepted).Int("replayed", replayed).Int("invalid", len(items)-len(scans)-replayed).Msg("Recorded attendance batch")
+
+	s.publishAttendanceUpdate(ctx, eventId, attendanceUpdate{
+		Type:      attendanceUpdateBatch,
+		ScannerID: scanner.ID,
+		Accepted:  accepted,
+		Rejected:  len(items) - accepted,
+	})
+
+	return openapi.Response(http.StatusOK, openapi.BatchAttendanceResponse{Results: results}), nil
+}
+
+// EventsEventIdAttendanceStreamTicketPost - Get a single use ticket to open the live attendance stream of an event
+func (s *APIService) EventsEventIdAttendanceStreamTicketPost(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdAttendanceStreamTicketPost").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleScanner)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	ticket, expireAt, err := s.createAttendanceStreamTicket(ctx, attendanceStreamGrant{
+		EventID: eventId,
+		UserID:  util.GetUserIDFromContext(ctx),
+		Email:   util.GetUserEmailFromContext(ctx),
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to create stream ticket")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	return openapi.Response(http.StatusCreated, openapi.AttendanceStreamTicket{
+		Ticket:   ticket,
+		ExpireAt: expireAt,
+	}), nil
+}
+
+// EventsEventIdCredentialsPost - Issue credentials of a type to registrants of the event
+func (s *APIService) EventsEventIdCredentialsPost(ctx context.Context, eventId string, credentialIssuanceRequest openapi.CredentialIssuanceRequest) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdCredentialsPost").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Str("typeID", credentialIssuanceRequest.TypeId).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	if eventClosed(*event) {
+		errMsg := fmt.Sprintf("Event is %s, cannot issue credentials", event.Status)
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	if _, ok := parseChainID(event.ChainID); !ok || !s.issuableContext(*event) {
+		errMsg := "Event chain ID or context ID not set, cannot issue credentials"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	recipients := credentialIssuanceRequest.Recipients
+	if len(recipients) == 0 || len(recipients) > maxCredentialRecipients {
+		errMsg := fmt.Sprintf("Issuance must have between 1 and %d recipients", maxCredentialRecipients)
+		logger.Info().Int("recipients", len(recipients)).Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	if !issuableCredentialType(*event, credentialIssuanceRequest.TypeId) {
+		errMsg := "Credential type is not registered for the event"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	credentialType, err := s.getCredentialType(ctx, credentialIssuanceRequest.TypeId)
+	if err != nil {
+		if err == errUnknownCredentialType {
+			errMsg := "Unknown credential type"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusBadRequest, errMsg), nil
+		}
+		logger.Err(err).Msg("Failed to get credential type")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	report := openapi.CredentialIssuanceReport{Results: make([]openapi.CredentialIssuanceResult, len(recipients))}
+	for i, recipient := range recipients {
+		result := &report.Results[i]
+		result.Email = recipient.Email
+		recipientLogger := logger.With().Str("recipient", recipient.Email).Logger()
+
+		address, err := mail.ParseAddress(recipient.Email)
+		if err != nil {
+			result.Status = credentialIssuanceStatusInvalid
+			result.Reason = "Invalid email"
+			continue
+		}
+		email := address.Address
+		if _, err := credentialType.claimValues(recipient.Claims); err != nil {
+			result.Status = credentialIssuanceStatusInvalid
+			result.Reason = err.Error()
+			continue
+		}
+
+		if _, err := s.dbClient.Registrations.GetOneByEventIdAndEmail(ctx, registrations.GetOneByEventIdAndEmailParams{
+			EventID: eventId,
+			Email:   email,
+		}); err != nil {
+			if err != pgx.ErrNoRows {
+				recipientLogger.Err(err).Msg("Failed to get registration")
+				return openapi.Response(http.StatusInternalServerError, nil), err
+			}
+			result.Status = credentialIssuanceStatusNotRegistered
+			continue
+		}
+
+		// queue the credential first, so recipients without an identity commitment get it once they set one
+		queued, err := s.dbClient.EventCredentials.QueueCredential(ctx, event_credentials.QueueCredentialParams{
+			EventID:     eventId,
+			Email:       email,
+			TypeID:      credentialType.TypeID,
+			ClaimValues: append([]string{}, recipient.Claims...), // the column does not accept NULL
+		})
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				result.Status = credentialIssuanceStatusDuplicate
+				continue
+			}
+			recipientLogger.Err(err).Msg("Failed to queue credential")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+
+		user, err := s.dbClient.Users.GetUserByEmail(ctx, email)
+		if err != nil && err != pgx.ErrNoRows {
+			recipientLogger.Err(err).Msg("Failed to get user")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		if err == pgx.ErrNoRows || user.IdentityCommitment == "" {
+			result.Status = credentialIssuanceStatusQueued
+			report.Queued++
+			continue
+		}
+		// a failed credential stays queued and is issued again when the recipient fetches their credentials
+		if err := s.issueEventCredential(ctx, *event, credentialType, queued, user.IdentityCommitment); err != nil {
+			recipientLogger.Warn().Err(err).Msg("Failed to issue credential, leaving it queued")
+			result.Status = credentialIssuanceStatusQueued
+			result.Reason = "Issuance failed, it is retried when the recipient fetches their credentials"
+			report.Queued++
+			continue
+		}
+		result.Status = credentialIssuanceStatusIssued
+		report.Issued++
+	}
+
+	logger.Info().Int("recipients", len(recipients)).Int32("issued", report.Issued).Int32("queued", report.Queued).Msg("Issued credentials")
+
+	return openapi.Response(http.StatusOK, report), nil
+}
+
+// EventsEventIdDelete - Delete an event with its registrations, credentials and attendance
+func (s *APIService) EventsEventIdDelete(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdDelete").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleOwner)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	// attendance and ticket credentials do not reference the event, delete them with it
+	tx, err := s.dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		logger.Err(err).Msg("Failed to begin transaction")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	defer tx.Rollback(ctx)
+	if err := s.dbClient.Attendances.WithTx(tx).DeleteByEventId(ctx, eventId); err != nil {
+		logger.Err(err).Msg("Failed to delete attendance")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if err := s.dbClient.TicketCredentials.WithTx(tx).DeleteByEventId(ctx, eventId); err != nil {
+		logger.Err(err).Msg("Failed to delete ticket credentials")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if err := s.dbClient.Events.WithTx(tx).DeleteEvent(ctx, eventId); err != nil {
+		logger.Err(err).Msg("Failed to delete event")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		logger.Err(err).Msg("Failed to commit event deletion")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Msg("Deleted event")
+
+	return openapi.Response(http.StatusNoContent, nil), nil
+}
+
+// EventsEventIdGet - Get event details
+func (s *APIService) EventsEventIdGet(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	// organizers find drafts and scheduled events in the event list of their organization
+	if !eventVisible(event, time.Now()) {
+		return openapi.Response(http.StatusNotFound, nil), nil
+	}
+	return openapi.Response(http.StatusOK, MarshalEvent(event)), nil
+}
+
+// EventsEventIdIntegrationsGet - List the ticketing platform events linked to an event
+func (s *APIService) EventsEventIdIntegrationsGet(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdIntegrationsGet").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	integrations, err := s.dbClient.EventIntegrations.ListIntegrationsByEventID(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to list integrations")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	return openapi.Response(http.StatusOK, MarshalEventIntegrations(integrations)), nil
+}
+
+// EventsEventIdIntegrationsPost - Link an event on a ticketing platform, so that its order webhooks register attendees
+func (s *APIService) EventsEventIdIntegrationsPost(ctx context.Context, eventId string, eventIntegrationInput openapi.EventIntegrationInput) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdIntegrationsPost").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	if webhook.GetProvider(eventIntegrationInput.Provider) == nil {
+		errMsg := "Unknown provider"
+		logger.Info().Str("provider", eventIntegrationInput.Provider).Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	if eventIntegrationInput.ExternalEventId == "" {
+		errMsg := "External event ID is required"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// the platform signs the webhooks of the external event with the secret of the integration
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		logger.Err(err).Msg("Failed to generate webhook secret")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	integration, err := s.dbClient.EventIntegrations.CreateIntegration(ctx, event_integrations.CreateIntegrationParams{
+		ID:              uuid.New().String(),
+		EventID:         eventId,
+		Provider:        eventIntegrationInput.Provider,
+		ExternalEventID: eventIntegrationInput.ExternalEventId,
+		Secret:          hex.EncodeToString(secret),
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "External event is already linked to this event"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusConflict, errMsg), nil
+		}
+		logger.Err(err).Msg("Failed to create integration")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Str("provider", integration.Provider).Str("externalEventID", integration.ExternalEventID).Msg("Linked external event")
+
+	return openapi.Response(http.StatusCreated, MarshalEventIntegration(integration)), nil
+}
+
+// EventsEventIdManifestGet - Download the signed verification manifest for offline scanners
+func (s *APIService) EventsEventIdManifestGet(ctx context.Context, eventId string, knownVersion string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdManifestGet").Str("eventID", eventId).Logger()
+	ctx = logger.WithContext(ctx)
+
+	scanner, rej, err := s.authorizeScanner(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize scanner")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+	logger = logger.With().Str("scannerID", scanner.ID).Logger()
+
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Err(err).Msg("Event not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	manifest := newEventManifest(event, s.allowedIssuerKeyIDs)
+	version, err := manifest.version()
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if knownVersion == version {
+		return openapi.Response(http.StatusNotModified, nil), nil
+	}
+
+	// the signature expires with the event, like the scanner token
+	var signedManifest string
+	if s.jwtService.SignsManifests() {
+		signedManifest, err = s.jwtService.GenerateManifestJWT(eventId, versionedManifest{manifest, version}, event.EndDate.Time)
+		if err != nil {
+			logger.Err(err).Msg("Failed to sign manifest")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+	}
+
+	logger.Info().Str("version", version).Msg("Served manifest")
+
+	return openapi.Response(http.StatusOK, openapi.EventManifest{
+		EventId:           manifest.EventID,
+		Version:           version,
+		ContextId:         manifest.ContextID,
+		ExternalNullifier: manifest.ExternalNullifier,
+		ChainId:           manifest.ChainID,
+		IssuerKeyIds:      manifest.IssuerKeyIDs,
+		CredentialTypeId:  manifest.CredentialTypeID,
+		VerificationKey:   manifest.VerificationKey,
+		StartDate:         manifest.StartDate,
+		EndDate:           manifest.EndDate,
+		AllowReentry:      manifest.AllowReentry,
+		RevocationCheck:   manifest.RevocationCheck,
+		Closed:            manifest.Closed,
+		SignedManifest:    signedManifest,
+	}), nil
+}
+
+// EventsEventIdPut - Update an event
+func (s *APIService) EventsEventIdPut(ctx context.Context, eventId string, eventInput openapi.EventInput) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdPut").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	if errMsg := validateEventInput(eventInput); errMsg != "" {
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// keep the ticket type unless it is changed, or the tickets no longer have the same claims
+	ticketTypeID := eventInput.TicketTypeId
+	if ticketTypeID == "" && eventInput.TieredTickets == event.TieredTickets {
+		ticketTypeID = event.TicketTypeID
+	}
+	ticketTypeID, errMsg, err := s.eventTicketType(ctx, ticketTypeID, event.OrganizationID, eventInput.TieredTickets, eventInput.RevocationCheck)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get ticket credential type")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if errMsg != "" {
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	// credentials already issued at the event would pass check-in as tickets of their type
+	if ticketTypeID != event.TicketTypeID {
+		issued, err := s.dbClient.EventCredentials.CountByEventIdAndTypeId(ctx, event_credentials.CountByEventIdAndTypeIdParams{
+			EventID: eventId,
+			TypeID:  ticketTypeID,
+		})
+		if err != nil {
+			logger.Err(err).Msg("Failed to count credentials of the ticket type")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		if issued > 0 {
+			errMsg := "Credentials of the ticket credential type were issued at the event"
+			logger.Info().Str("ticketTypeID", ticketTypeID).Msg(errMsg)
+			return openapi.Response(http.StatusBadRequest, errMsg), nil
+		}
+	}
+	credentialTypeIDs, errMsg, err := s.eventCredentialTypes(ctx, eventInput.CredentialTypeIds, event.OrganizationID, ticketTypeID)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get credential types")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if errMsg != "" {
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	updated, err := s.dbClient.Events.UpdateEvent(ctx, events.UpdateEventParams{
+		ID:                    eventId,
+		Name:                  eventInput.Name,
+		Description:           eventInput.Description,
+		Url:                   eventInput.Url,
+		ChainID:               eventInput.ChainId,
+		IssuerKeyID:           eventInput.IssuerKeyId,
+		StartDate:             pgtype.Timestamptz{Time: eventInput.StartDate, Valid: true},
+		EndDate:               pgtype.Timestamptz{Time: eventInput.EndDate, Valid: true},
+		VerificationKey:       eventInput.VerificationKey,
+		AllowReentry:          eventInput.AllowReentry,
+		Capacity:              pgtype.Int4{Int32: eventInput.Capacity, Valid: eventInput.Capacity > 0},
+		RegistrationMode:      registrationModeOrDefault(eventInput.RegistrationMode),
+		AllowedEmailDomains:   normalizeEmailDomains(eventInput.AllowedEmailDomains),
+		RegistrationDeadline:  pgtype.Timestamptz{Time: eventInput.RegistrationDeadline, Valid: !eventInput.RegistrationDeadline.IsZero()},
+		Status:                eventStatusOrDefault(eventInput.Status, event.Status),
+		PublishAt:             pgtype.Timestamptz{Time: eventInput.PublishAt, Valid: !eventInput.PublishAt.IsZero()},
+		TicketExpiryPolicy:    ticketExpiryPolicyOrDefault(eventInput.TicketExpiryPolicy),
+		TicketValiditySeconds: eventInput.TicketValiditySeconds,
+		TieredTickets:         eventInput.TieredTickets,
+		TicketTypeID:          ticketTypeID,
+		CredentialTypeIds:     credentialTypeIDs,
+		RevocationCheck:       eventInput.RevocationCheck,
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to update event")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Msg("Updated event")
+
+	// a raised capacity gives places to the waitlist
+	if _, err := s.fillFromWaitlist(ctx, eventId); err != nil {
+		logger.Warn().Err(err).Msg("Failed to fill places from the waitlist")
+	}
+
+	return openapi.Response(http.StatusOK, MarshalEvent(updated)), nil
+}
+
+// EventsEventIdRegisterPost - Register the user for an open event, or for a domain event with an email of an allowed domain
+func (s *APIService) EventsEventIdRegisterPost(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	userEmail := util.GetUserEmailFromContext(ctx)
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRegisterPost").Str("eventID", eventId).Str("email", userEmail).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Info().Msg("Event not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej := checkSelfRegistration(event, userEmail, time.Now()); rej != nil {
+		logger.Info().Msg(rej.reason)
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	return s.registerOrWaitlist(ctx, event, userEmail)
+}
+
+// EventsEventIdRegistrationDelete - Cancel the registration of the user, the ticket of the user is deleted and revoked
+func (s *APIService) EventsEventIdRegistrationDelete(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	userEmail := util.GetUserEmailFromContext(ctx)
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRegistrationDelete").Str("eventID", eventId).Str("email", userEmail).Logger()
+
+	cancelled, err := s.cancelRegistration(ctx, eventId, userEmail, revocationReasonCancelled)
+	if err != nil {
+		logger.Err(err).Msg("Failed to cancel registration")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if !cancelled {
+		errMsg := "No user registration found for this event"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusNotFound, errMsg), nil
+	}
+
+	logger.Info().Msg("Cancelled registration")
+
+	return openapi.Response(http.StatusNoContent, nil), nil
+}
+
+// EventsEventIdRegistrationsEmailDelete - Cancel the registration of an attendee, the ticket of the attendee is deleted and revoked
+func (s *APIService) EventsEventIdRegistrationsEmailDelete(ctx context.Context, eventId string, email string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRegistrationsEmailDelete").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Str("attendeeEmail", email).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	cancelled, err := s.cancelRegistration(ctx, eventId, email, revocationReasonCancelledByOrganizer)
+	if err != nil {
+		logger.Err(err).Msg("Failed to cancel registration")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if !cancelled {
+		errMsg := "Email is not registered for this event"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusNotFound, errMsg), nil
+	}
+
+	logger.Info().Msg("Cancelled registration")
+
+	return openapi.Response(http.StatusNoContent, nil), nil
+}
+
+// EventsEventIdRegistrationsEmailPut - Update the registration of an attendee, such as the ticket tier
+func (s *APIService) EventsEventIdRegistrationsEmailPut(ctx context.Context, eventId string, email string, registrationInput openapi.RegistrationInput) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRegistrationsEmailPut").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Str("attendeeEmail", email).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	if !validTicketTier(registrationInput.Tier) {
+		errMsg := fmt.Sprintf("Invalid tier %q, must be %s, %s or %s", registrationInput.Tier, ticketTierGeneral, ticketTierVIP, ticketTierSpeaker)
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// tickets already issued keep the tier they were issued with
+	registration, err := s.dbClient.Registrations.UpdateTier(ctx, registrations.UpdateTierParams{
+		Tier:    registrationInput.Tier,
+		EventID: eventId,
+		Email:   email,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "Email is not registered for this event"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusNotFound, errMsg), nil
+		}
+		logger.Err(err).Msg("Failed to update registration")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Str("tier", registration.Tier).Msg("Updated registration")
+
+	return openapi.Response(http.StatusOK, MarshalRegistration(registration)), nil
+}
+
+// EventsEventIdRegistrationsImportPost - Import registrations from a CSV of emails
+func (s *APIService) EventsEventIdRegistrationsImportPost(ctx context.Context, eventId string, registrationImportRequest openapi.RegistrationImportRequest) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRegistrationsImportPost").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	if registrationClosed(*event, time.Now()) {
+		errMsg := "Registration has closed"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	emails, invalid, err := csvimport.ParseEmails(strings.NewReader(registrationImportRequest.Csv))
+	if err != nil {
+		errMsg := fmt.Sprintf("Invalid CSV file, %v", err)
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	report, err := csvimport.ImportRegistrations(ctx, s.dbClient, eventId, emails, registrationImportRequest.Sync)
+	if err != nil {
+		if err == csvimport.ErrNothingToSync {
+			errMsg := "File has no valid emails, cannot sync"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusBadRequest, errMsg), nil
+		}
+		logger.Err(err).Msg("Failed to import registrations")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	report.Invalid = invalid
+
+	// places freed by a sync go to the waitlist
+	if report.Removed > 0 {
+		if _, err := s.fillFromWaitlist(ctx, eventId); err != nil {
+			logger.Warn().Err(err).Msg("Failed to fill freed places from the waitlist")
+		}
+	}
+
+	logger.Info().Int("added", report.Added).Int("unchanged", report.Unchanged).Int("waitlisted", report.Waitlisted).Int("removed", report.Removed).Int("removedFromWaitlist", report.RemovedFromWaitlist).Int("revoked", report.Revoked).Int("invalid", len(invalid)).Msg("Imported registrations")
+
+	return openapi.Response(http.StatusOK, MarshalRegistrationImportReport(*report)), nil
+}
+
+// EventsEventIdRequestTicketCredentialPost - Request a new ticket credential for an event
+func (s *APIService) EventsEventIdRequestTicketCredentialPost(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRequestTicketCredentialPost").Str("eventID", eventId).Logger()
+	userEmail := util.GetUserEmailFromContext(ctx)
+	userID := util.GetUserIDFromContext(ctx)
+	if userID == "" || userEmail == "" {
+		return openapi.Response(http.StatusUnauthorized, nil), nil
+	}
+	logger = logger.With().Str("email", userEmail).Str("uid", userID).Logger()
+
+	// check registration
+	registration, err := s.dbClient.Registrations.GetOneByEventIdAndEmail(ctx, registrations.GetOneByEventIdAndEmailParams{
+		EventID: eventId,
+		Email:   userEmail,
+	})
+	if err != nil {
+		if err != pgx.ErrNoRows {
+			logger.Err(err).Msg("Failed to get registration")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		errMsg := "No user registration found for this event"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// get user from database
+	user, err := s.dbClient.Users.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Err(err).Msg("User not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	if user.IdentityCommitment == "" {
+		errMsg := "User identity commitment not set, cannot generate ticket credential"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// get event
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Err(err).Msg("Event not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if eventClosed(event) {
+		errMsg := fmt.Sprintf("Event is %s, cannot generate ticket credential", event.Status)
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// ensure user does not already have a ticket for this event, stored, pre-issued or issued
+	hasTicket, err := s.hasTicket(ctx, event, userEmail)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get existing tickets")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if hasTicket {
+		errMsg := "User already has a ticket credential for this event, cannot request again"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	if !s.issuableContext(event) {
+		errMsg := "Event context ID not set, cannot generate ticket credential"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	// registrations imported by the organizer are held to the domain rule too
+	if !emailDomainAllowed(event, userEmail) {
+		errMsg := "Tickets are restricted to emails of the allowed domains"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusForbidden, errMsg), nil
+	}
+
+	// tickets are signed for the chain of the event and expire under its policy
+	chainID, ok := parseChainID(event.ChainID)
+	if !ok {
+		errMsg := "Event chain ID is invalid, cannot generate ticket credential"
+		logger.Info().Str("chainID", event.ChainID).Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	issuedAt := time.Now()
+	expireAt := ticketExpiry(event, issuedAt)
+	if !expireAt.After(issuedAt) {
+		errMsg := "Tickets for this event have expired, cannot generate ticket credential"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// create ticket credential. The ticket ID is random, check-in proofs reveal it and it must not be
+	// derived from the email.
+	credentialID, err := util.RandomUint248()
+	if err != nil {
+		logger.Err(err).Msg("Failed to generate ticket ID")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	credentialType, err := s.getCredentialType(ctx, event.TicketTypeID)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get ticket credential type")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	request, err := newTicketRequest(event, credentialType, ticketClaims(event, registration.Tier), credentialID.String(), chainID, user.IdentityCommitment, expireAt)
+	if err != nil {
+		logger.Err(err).Msg("Failed to build ticket credential")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	// record the ticket before issuing it so that every issued ticket can be revoked, a concurrent
+	// request that recorded one first wins
+	recorded, err := s.dbClient.IssuedTickets.CreateIssuedTicket(ctx, issued_tickets.CreateIssuedTicketParams{
+		CredentialID: credentialID.String(),
+		EventID:      eventId,
+		Email:        userEmail,
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to record issued ticket")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if recorded == 0 {
+		errMsg := "User already has a ticket credential for this event, cannot request again"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	resp, err := s.issuerClient.GenerateSignedCredential(ctx, request)
+	if err != nil {
+		logger.Err(err).Msg("Failed to generate ticket credential")
+		// the ticket was never signed, the user can request it again
+		if err := s.dbClient.IssuedTickets.DeleteByCredentialID(ctx, credentialID.String()); err != nil {
+			logger.Warn().Err(err).Msg("Failed to delete unsigned ticket")
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	// count the issuance for event stats, the credential has already been issued so a failure is not returned
+	if err := s.dbClient.TicketIssuances.CreateOne(ctx, eventId); err != nil {
+		logger.Warn().Err(err).Msg("Failed to record ticket issuance")
+	}
+
+	logger.Info().Msg("Generated ticket credential")
+	return openapi.Response(http.StatusCreated, openapi.UnencryptedTicketCredential{
+		EventId:    eventId,
+		ChainId:    fmt.Sprint(chainID),
+		Credential: resp.GetSignedCred(),
+		IssuedAt:   issuedAt,
+		ExpireAt:   expireAt,
+	}), nil
+}
+
+// EventsEventIdRevocationsGet - Get the revocation list of an event, scanners reject the tickets on it
+func (s *APIService) EventsEventIdRevocationsGet(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRevocationsGet").Str("eventID", eventId).Logger()
+	ctx = logger.WithContext(ctx)
+
+	scanner, rej, err := s.authorizeScanner(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize scanner")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	revoked, err := s.dbClient.IssuedTickets.ListRevokedByEventId(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to list revoked tickets")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Str("scannerID", scanner.ID).Int("revoked", len(revoked)).Msg("Served revocation list")
+
+	return openapi.Response(http.StatusOK, MarshalRevokedTickets(revoked)), nil
+}
+
+// EventsEventIdRevocationsPost - Revoke the tickets issued to an email, for refunds and bans
+func (s *APIService) EventsEventIdRevocationsPost(ctx context.Context, eventId string, ticketRevocationRequest openapi.TicketRevocationRequest) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdRevocationsPost").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+	if !event.RevocationCheck {
+		errMsg := "Event does not check ticket revocation, revoked tickets would still be accepted"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	reason := ticketRevocationRequest.Reason
+	if reason == "" {
+		reason = revocationReasonOrganizer
+	}
+	revoked, err := s.dbClient.IssuedTickets.RevokeByEventIdAndEmails(ctx, issued_tickets.RevokeByEventIdAndEmailsParams{
+		EventID:          eventId,
+		Emails:           []string{ticketRevocationRequest.Email},
+		RevocationReason: reason,
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to revoke tickets")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if len(revoked) == 0 {
+		errMsg := "No ticket to revoke for this email"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusNotFound, errMsg), nil
+	}
+
+	logger.Info().Int("revoked", len(revoked)).Str("reason", reason).Msg("Revoked tickets")
+
+	return openapi.Response(http.StatusOK, MarshalRevokedTickets(revoked)), nil
+}
+
+// EventsEventIdScannersPost - Register a scanner device for an event
+func (s *APIService) EventsEventIdScannersPost(ctx context.Context, eventId string, registerScannerRequest openapi.RegisterScannerRequest) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdScannersPost").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+	if registerScannerRequest.Name == "" {
+		errMsg := "Scanner name is required"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	if !event.EndDate.Time.After(time.Now()) {
+		errMsg := "Event has already ended"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	scanner, err := s.dbClient.Scanners.CreateScanner(ctx, scanners.CreateScannerParams{
+		ID:      uuid.New().String(),
+		EventID: eventId,
+		Name:    registerScannerRequest.Name,
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to create scanner")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	// the token is valid until the event ends, unless the scanner is revoked first
+	token, err := s.jwtService.GenerateScannerJWT(scanner.ID, eventId, event.EndDate.Time)
+	if err != nil {
+		logger.Err(err).Msg("Failed to generate scanner token")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Str("scannerID", scanner.ID).Str("name", scanner.Name).Msg("Registered scanner")
+
+	credential := openapi.ScannerCredential{
+		Scanner: MarshalScanner(scanner),
+		Token:   token,
+	}
+	if s.jwtService.SignsManifests() {
+		credential.ManifestPublicKey = base64.StdEncoding.EncodeToString(s.jwtService.ManifestPublicKey())
+	}
+
+	return openapi.Response(http.StatusCreated, credential), nil
+}
+
+// EventsEventIdScannersScannerIdRevokePost - Revoke a scanner device
+func (s *APIService) EventsEventIdScannersScannerIdRevokePost(ctx context.Context, eventId string, scannerId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdScannersScannerIdRevokePost").Str("eventID", eventId).Str("scannerID", scannerId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	scanner, err := s.dbClient.Scanners.RevokeScanner(ctx, scanners.RevokeScannerParams{
+		ID:      scannerId,
+		EventID: eventId,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Info().Msg("Scanner not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		logger.Err(err).Msg("Failed to revoke scanner")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Msg("Revoked scanner")
+
+	return openapi.Response(http.StatusOK, MarshalScanner(scanner)), nil
+}
+
+// EventsEventIdStatsGet - Get registration, issuance and check-in statistics for an event
+func (s *APIService) EventsEventIdStatsGet(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdStatsGet").Str("eventID", eventId).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleScanner)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	// only aggregates are read, attendance is never joined with emails
+	registered, err := s.dbClient.Registrations.CountEventRegistrations(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to count registrations")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	requested, err := s.dbClient.TicketIssuances.CountByEventId(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to count ticket issuances")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	stored, err := s.dbClient.TicketCredentials.CountByEventId(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to count ticket credentials")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	checkedIn, err := s.dbClient.Attendances.CountByEventId(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to count attendance")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	hourly, err := s.dbClient.Attendances.GetHourlyCheckInsByEventId(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get hourly check-ins")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	return openapi.Response(http.StatusOK, openapi.EventStats{
+		EventId:                    eventId,
+		Registered:                 registered,
+		TicketCredentialsRequested: requested,
+		TicketCredentialsStored:    stored,
+		CheckedIn:                  checkedIn,
+		HourlyCheckIns:             MarshalHourlyCheckIns(hourly),
+	}), nil
+}
+
+// EventsEventIdTicketIssuanceJobIdGet - Get the progress of a ticket issuance job
+func (s *APIService) EventsEventIdTicketIssuanceJobIdGet(ctx context.Context, eventId string, jobId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdTicketIssuanceJobIdGet").Str("eventID", eventId).Str("jobID", jobId).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	job, err := s.dbClient.TicketIssuanceJobs.GetJob(ctx, ticket_issuance_jobs.GetJobParams{
+		ID:      jobId,
+		EventID: eventId,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "Ticket issuance job not found"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusNotFound, errMsg), nil
+		}
+		logger.Err(err).Msg("Failed to get ticket issuance job")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	return openapi.Response(http.StatusOK, MarshalTicketIssuanceJob(job)), nil
+}
+
+// EventsEventIdTicketIssuancePost - Start a job pre-issuing tickets to the registrants of an event
+func (s *APIService) EventsEventIdTicketIssuancePost(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdTicketIssuancePost").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	if eventClosed(*event) {
+		errMsg := fmt.Sprintf("Event is %s, cannot issue tickets", event.Status)
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	if _, ok := parseChainID(event.ChainID); !ok || !s.issuableContext(*event) {
+		errMsg := "Event chain ID or context ID not set, cannot issue tickets"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	now := time.Now()
+	if !ticketExpiry(*event, now).After(now) {
+		errMsg := "Tickets for this event have expired, cannot issue tickets"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	job, claimed, err := s.claimTicketIssuanceJob(ctx, eventId, now)
+	if err != nil {
+		logger.Err(err).Msg("Failed to create ticket issuance job")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if !claimed {
+		errMsg := "A ticket issuance job is already running for this event"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusConflict, errMsg), nil
+	}
+
+	// the job outlives the request, its progress is polled with the job ID
+	go s.runTicketIssuanceJob(context.WithoutCancel(ctx), job, *event)
+
+	logger.Info().Str("jobID", job.ID).Msg("Started ticket issuance job")
+
+	return openapi.Response(http.StatusAccepted, MarshalTicketIssuanceJob(job)), nil
+}
+
+// EventsEventIdWaitlistDelete - Leave the waitlist of an event
+func (s *APIService) EventsEventIdWaitlistDelete(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	userEmail := util.GetUserEmailFromContext(ctx)
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdWaitlistDelete").Str("eventID", eventId).Str("email", userEmail).Logger()
+
+	deleted, err := s.dbClient.Waitlist.DeleteByEventIdAndEmail(ctx, waitlist.DeleteByEventIdAndEmailParams{
+		EventID: eventId,
+		Email:   userEmail,
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to leave waitlist")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if deleted == 0 {
+		errMsg := "User is not on the waitlist"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusNotFound, errMsg), nil
+	}
+
+	logger.Info().Msg("Left waitlist")
+
+	return openapi.Response(http.StatusNoContent, nil), nil
+}
+
+// EventsEventIdWaitlistGet - List the waitlist of an event in order
+func (s *APIService) EventsEventIdWaitlistGet(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdWaitlistGet").Str("eventID", eventId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeEvent(ctx, eventId, roleAdmin)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	entries, err := s.dbClient.Waitlist.ListByEventId(ctx, eventId)
+	if err != nil {
+		logger.Err(err).Msg("Failed to list waitlist")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	return openapi.Response(http.StatusOK, MarshalWaitlist(entries)), nil
+}
+
+// EventsEventIdWaitlistPost - Join the waitlist of a full event, the user is registered right away if there is a free place
+func (s *APIService) EventsEventIdWaitlistPost(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
+	userEmail := util.GetUserEmailFromContext(ctx)
+	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdWaitlistPost").Str("eventID", eventId).Str("email", userEmail).Logger()
+	ctx = logger.WithContext(ctx)
+
+	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Info().Msg("Event not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if !event.Capacity.Valid {
+		errMsg := "Event has no capacity limit, there is no waitlist"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	// the waitlist leads to a registration, so it is open to the people who can register
+	if rej := checkSelfRegistration(event, userEmail, time.Now()); rej != nil {
+		logger.Info().Msg(rej.reason)
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	return s.registerOrWaitlist(ctx, event, userEmail)
+}
+
+// EventsGet - Get list of events
+func (s *APIService) EventsGet(ctx context.Context) (openapi.ImplResponse, error) {
+	events, err := s.dbClient.Events.ListPublishedEvents(ctx)
+	if err != nil {
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	return openapi.Response(http.StatusOK, MarshalEvents(events)), nil
+}
+
+// EventsPost - Create an event
+func (s *APIService) EventsPost(ctx context.Context, eventInput openapi.EventInput) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "EventsPost").Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	// events are created in an organization the user administers, platform admins may leave it empty
+	if eventInput.OrganizationId == "" && !s.isPlatformAdmin(ctx) {
+		errMsg := "Organization ID is required"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	if eventInput.OrganizationId != "" {
+		_, rej, err := s.authorizeOrganization(ctx, eventInput.OrganizationId, roleAdmin)
+		if err != nil {
+			logger.Err(err).Msg("Failed to authorize user")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		if rej != nil {
+			return openapi.Response(rej.status, rej.reason), nil
+		}
+		if _, err := s.dbClient.Organizations.GetOrganizationByID(ctx, eventInput.OrganizationId); err != nil {
+			if err == pgx.ErrNoRows {
+				errMsg := "Organization not found"
+				logger.Info().Msg(errMsg)
+				return openapi.Response(http.StatusNotFound, errMsg), nil
+			}
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+	}
+
+	if errMsg := validateEventInput(eventInput); errMsg != "" {
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	organizationID := pgtype.Text{String: eventInput.OrganizationId, Valid: eventInput.OrganizationId != ""}
+	ticketTypeID, errMsg, err := s.eventTicketType(ctx, eventInput.TicketTypeId, organizationID, eventInput.TieredTickets, eventInput.RevocationCheck)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get ticket credential type")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if errMsg != "" {
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+	credentialTypeIDs, errMsg, err := s.eventCredentialTypes(ctx, eventInput.CredentialTypeIds, organizationID, ticketTypeID)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get credential types")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if errMsg != "" {
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	contextID, err := s.newEventContextID()
+	if err != nil {
+		logger.Err(err).Msg("Failed to assign context ID")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	event, err := s.dbClient.Events.CreateEvent(ctx, events.CreateEventParams{
+		ID:                    uuid.New().String(),
+		Name:                  eventInput.Name,
+		Description:           eventInput.Description,
+		Url:                   eventInput.Url,
+		ChainID:               eventInput.ChainId,
+		ContextID:             contextID,
+		IssuerKeyID:           eventInput.IssuerKeyId,
+		StartDate:             pgtype.Timestamptz{Time: eventInput.StartDate, Valid: true},
+		EndDate:               pgtype.Timestamptz{Time: eventInput.EndDate, Valid: true},
+		VerificationKey:       eventInput.VerificationKey,
+		AllowReentry:          eventInput.AllowReentry,
+		Capacity:              pgtype.Int4{Int32: eventInput.Capacity, Valid: eventInput.Capacity > 0},
+		RegistrationMode:      registrationModeOrDefault(eventInput.RegistrationMode),
+		AllowedEmailDomains:   normalizeEmailDomains(eventInput.AllowedEmailDomains),
+		RegistrationDeadline:  pgtype.Timestamptz{Time: eventInput.RegistrationDeadline, Valid: !eventInput.RegistrationDeadline.IsZero()},
+		Status:                eventStatusOrDefault(eventInput.Status, eventStatusDraft),
+		PublishAt:             pgtype.Timestamptz{Time: eventInput.PublishAt, Valid: !eventInput.PublishAt.IsZero()},
+		TicketExpiryPolicy:    ticketExpiryPolicyOrDefault(eventInput.TicketExpiryPolicy),
+		TicketValiditySeconds: eventInput.TicketValiditySeconds,
+		TieredTickets:         eventInput.TieredTickets,
+		TicketTypeID:          ticketTypeID,
+		CredentialTypeIds:     credentialTypeIDs,
+		RevocationCheck:       eventInput.RevocationCheck,
+		OrganizationID:        organizationID,
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to create event")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Str("eventID", event.ID).Msg("Created event")
+
+	return openapi.Response(http.StatusCreated, MarshalEvent(event)), nil
+}
+
+// HealthGet - Check the health of the API
+func (s *APIService) HealthGet(ctx context.Context) (openapi.ImplResponse, error) {
+	return openapi.Response(http.StatusOK, "OK"), nil
+}
+
+// OrganizationsGet - List the organizations of the user
+func (s *APIService) OrganizationsGet(ctx context.Context) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsGet").Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+
+	memberships, err := s.dbClient.OrganizationMembers.ListMembershipsByUserID(ctx, util.GetUserIDFromContext(ctx))
+	if err != nil {
+		logger.Err(err).Msg("Failed to list memberships")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	roles := make(map[string]string, len(memberships))
+	ids := make([]string, len(memberships))
+	for i, membership := range memberships {
+		roles[membership.OrganizationID] = membership.Role
+		ids[i] = membership.OrganizationID
+	}
+
+	orgs, err := s.dbClient.Organizations.ListOrganizationsByIDs(ctx, ids)
+	if err != nil {
+		logger.Err(err).Msg("Failed to list organizations")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	marshaledOrgs := make([]openapi.Organization, len(orgs))
+	for i, org := range orgs {
+		marshaledOrgs[i] = MarshalOrganization(org, roles[org.ID])
+	}
+	return openapi.Response(http.StatusOK, marshaledOrgs), nil
+}
+
+// OrganizationsOrganizationIdCredentialTypesGet - List the credential types an organization can issue, the primitive types and the types it defined
+func (s *APIService) OrganizationsOrganizationIdCredentialTypesGet(ctx context.Context, organizationId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsOrganizationIdCredentialTypesGet").Str("organizationID", organizationId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeOrganization(ctx, organizationId, roleScanner)
+	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
+
+	rows, err := s.dbClient.CredentialTypes.ListByOrganizationId(ctx, pgtype.Text{String: organizationId, Valid: true})
+	if err != nil {
+		logger.Err(err).Msg("Failed to list credential types")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	types := make([]openapi.CredentialType, len(rows))
+	for i, row := range rows {
+		credentialType, err := newCredentialType(row)
+		if err != nil {
+			logger.Err(err).Msg("Failed to parse credential type")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		types[i] = MarshalCredentialType(credentialType)
+	}
+	return openapi.Response(http.StatusOK, types), nil
 }
 
-// EventsEventIdAttendancePost - Record attendance for an event
-func (s *APIService) EventsEventIdAttendancePost(ctx context.Context, eventId string, recordAttendanceRequest openapi.RecordAttendanceRequest) (openapi.ImplResponse, error) {
-	logger := log.Ctx(ctx).With().Str("op", "EventsEventIdAttendancePost").Str("eventID", eventId).Logger()
+// OrganizationsOrganizationIdCredentialTypesPost - Register a credential type of the organization
+func (s *APIService) OrganizationsOrganizationIdCredentialTypesPost(ctx context.Context, organizationId string, credentialTypeInput openapi.CredentialTypeInput) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsOrganizationIdCredentialTypesPost").Str("organizationID", organizationId).Str("email", util.GetUserEmailFromContext(ctx)).Str("typeID", credentialTypeInput.TypeId).Logger()
 	ctx = logger.WithContext(ctx)
 
-	credType := recordAttendanceRequest.Type
-	credContext := recordAttendanceRequest.Context
-	nullifier := recordAttendanceRequest.Nullifier
-	// issuerKeyID := recordAttendanceRequest.KeyId
-	eventID := recordAttendanceRequest.EventId
-	adminCode := recordAttendanceRequest.AdminCode
-
-	// validate event admin code
-	event, err := s.dbClient.Events.GetEventByID(ctx, eventID)
+	_, rej, err := s.authorizeOrganization(ctx, organizationId, roleAdmin)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			logger.Err(err).Msg("Event not found")
-			return openapi.Response(http.StatusNotFound, nil), nil
-		}
+		logger.Err(err).Msg("Failed to authorize user")
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
-	if event.AdminCode != adminCode {
-		errMsg := "Invalid admin code"
-		logger.Info().Msg(errMsg)
-		return openapi.Response(http.StatusUnauthorized, errMsg), nil
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
 	}
 
-	// validate credential type
-	if credType != fmt.Sprint(unitCredentialTypeID) {
-		errMsg := "Invalid credential type"
+	if err := validateCredentialTypeInput(credentialTypeInput); err != nil {
+		errMsg := fmt.Sprintf("Invalid credential type, %v", err)
 		logger.Info().Msg(errMsg)
 		return openapi.Response(http.StatusBadRequest, errMsg), nil
 	}
 
-	// validate credential context
-	if credContext != event.ContextID {
-		errMsg := "Invalid credential context"
-		logger.Info().Msg(errMsg)
-		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	schemas := make([]claimSchema, len(credentialTypeInput.Claims))
+	for i, claim := range credentialTypeInput.Claims {
+		schemas[i] = claimSchemaFromInput(claim)
+	}
+	claims, err := json.Marshal(schemas)
+	if err != nil {
+		logger.Err(err).Msg("Failed to marshal claims")
+		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
 
-	// TODO: validate issuer
-
-	attendance, err := s.dbClient.Attendances.CreateOne(ctx, attendances.CreateOneParams{
-		EventID:   eventID,
-		Nullifier: nullifier,
+	// type IDs are global in the protocol, so a type registered by another organization cannot be taken over
+	row, err := s.dbClient.CredentialTypes.CreateCredentialType(ctx, credential_types.CreateCredentialTypeParams{
+		TypeID:            credentialTypeInput.TypeId,
+		Name:              credentialTypeInput.Name,
+		OrganizationID:    pgtype.Text{String: organizationId, Valid: true},
+		Claims:            claims,
+		Revocable:         credentialTypeInput.Revocable,
+		DefaultTtlSeconds: credentialTypeInput.DefaultTtlSeconds,
 	})
 	if err != nil {
-		logger.Err(err).Msg("Failed to record attendance")
+		if err == pgx.ErrNoRows {
+			errMsg := "A credential type with this type ID is already registered"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusConflict, errMsg), nil
+		}
+		logger.Err(err).Msg("Failed to create credential type")
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
 
-	logger.Info().Str("nullifier", nullifier).Int32("attendance", attendance.ID).Msg("Recorded attendance")
+	logger.Info().Msg("Registered credential type")
 
-	return openapi.Response(http.StatusCreated, nil), nil
+	return openapi.Response(http.StatusCreated, MarshalCredentialType(&credentialType{CredentialType: row, claims: schemas})), nil
 }
 
-// EventsEventIdGet - Get event details
-func (s *APIService) EventsEventIdGet(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
-	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+// OrganizationsOrganizationIdEventsGet - List every event of an organization, including drafts and scheduled events
+func (s *APIService) OrganizationsOrganizationIdEventsGet(ctx context.Context, organizationId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsOrganizationIdEventsGet").Str("organizationID", organizationId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeOrganization(ctx, organizationId, roleScanner)
 	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
-	return openapi.Response(http.StatusOK, MarshalEvent(event)), nil
-}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
 
-// EventsEventIdRequestTicketCredentialPost - Request a new ticket credential for an event
-func (s *APIService) EventsEventIdRequestTicketCredentialPost(ctx context.Context, eventId string) (openapi.ImplResponse, error) {
-	logger := log.Ctx(ctx).With().Str("op", "UserMeEmailCredentialPut").Logger()
-	userEmail := util.GetUserEmailFromContext(ctx)
-	userID := util.GetUserIDFromContext(ctx)
-	if userID == "" || userEmail == "" {
-		return openapi.Response(http.StatusUnauthorized, nil), nil
+	events, err := s.dbClient.Events.ListEventsByOrganizationID(ctx, pgtype.Text{String: organizationId, Valid: true})
+	if err != nil {
+		logger.Err(err).Msg("Failed to list events")
+		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
-	logger = logger.With().Str("email", userEmail).Str("uid", userID).Logger()
+	return openapi.Response(http.StatusOK, MarshalEvents(events)), nil
+}
 
-	// ensure user does not already have a ticket credential for this event
-	_, err := s.dbClient.TicketCredentials.GetByEventIdAndEmail(ctx, ticket_credentials.GetByEventIdAndEmailParams{
-		EventID: eventId,
-		Email:   userEmail,
-	})
+// OrganizationsOrganizationIdMembersGet - List the members of an organization
+func (s *APIService) OrganizationsOrganizationIdMembersGet(ctx context.Context, organizationId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsOrganizationIdMembersGet").Str("organizationID", organizationId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	_, rej, err := s.authorizeOrganization(ctx, organizationId, roleAdmin)
 	if err != nil {
-		if err != pgx.ErrNoRows {
-			logger.Err(err).Msg("Failed to get existing ticket credential")
-			return openapi.Response(http.StatusInternalServerError, nil), err
-		}
-	} else {
-		errMsg := "User already has a ticket credential for this event, cannot request again"
-		logger.Info().Msg(errMsg)
-		return openapi.Response(http.StatusBadRequest, errMsg), nil
+		logger.Err(err).Msg("Failed to authorize user")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
 	}
 
-	// check registration
-	_, err = s.dbClient.Registrations.GetOneByEventIdAndEmail(ctx, registrations.GetOneByEventIdAndEmailParams{
-		EventID: eventId,
-		Email:   userEmail,
-	})
+	members, err := s.dbClient.OrganizationMembers.ListMembers(ctx, organizationId)
 	if err != nil {
-		if err != pgx.ErrNoRows {
-			logger.Err(err).Msg("Failed to get registration")
-			return openapi.Response(http.StatusInternalServerError, nil), err
-		}
-		errMsg := "No user registration found for this event"
-		logger.Info().Msg(errMsg)
-		return openapi.Response(http.StatusBadRequest, errMsg), nil
+		logger.Err(err).Msg("Failed to list members")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	userIDs := make([]string, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+	memberUsers, err := s.dbClient.Users.ListUsersByIDs(ctx, userIDs)
+	if err != nil {
+		logger.Err(err).Msg("Failed to list member users")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	emails := make(map[string]string, len(memberUsers))
+	for _, user := range memberUsers {
+		emails[user.ID] = user.Email
 	}
 
-	// get user from database
-	user, err := s.dbClient.Users.GetUserByID(ctx, userID)
+	marshaledMembers := make([]openapi.OrganizationMember, len(members))
+	for i, member := range members {
+		marshaledMembers[i] = MarshalOrganizationMember(member, emails[member.UserID])
+	}
+	return openapi.Response(http.StatusOK, marshaledMembers), nil
+}
+
+// OrganizationsOrganizationIdMembersPut - Add a member to an organization or change the role of a member
+func (s *APIService) OrganizationsOrganizationIdMembersPut(ctx context.Context, organizationId string, organizationMemberInput openapi.OrganizationMemberInput) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsOrganizationIdMembersPut").Str("organizationID", organizationId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	callerRole, rej, err := s.authorizeOrganization(ctx, organizationId, roleAdmin)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			logger.Err(err).Msg("User not found")
-			return openapi.Response(http.StatusNotFound, nil), nil
-		}
+		logger.Err(err).Msg("Failed to authorize user")
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
 
-	if user.IdentityCommitment == "" {
-		errMsg := "User identity commitment not set, cannot generate ticket credential"
-		logger.Info().Msg(errMsg)
+	role := organizationMemberInput.Role
+	if !validRole(role) {
+		errMsg := "Role must be owner, admin or scanner"
+		logger.Info().Str("role", role).Msg(errMsg)
 		return openapi.Response(http.StatusBadRequest, errMsg), nil
 	}
 
-	// get event
-	event, err := s.dbClient.Events.GetEventByID(ctx, eventId)
+	// members are added by email, they must have signed in at least once
+	user, err := s.dbClient.Users.GetUserByEmail(ctx, organizationMemberInput.Email)
 	if err != nil {
 		if err == pgx.ErrNoRows {
-			logger.Err(err).Msg("Event not found")
-			return openapi.Response(http.StatusNotFound, nil), nil
+			errMsg := "User not found"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusNotFound, errMsg), nil
 		}
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
-	if event.ContextID == "" {
-		errMsg := "Event context ID not set, cannot generate ticket credential"
+	logger = logger.With().Str("memberID", user.ID).Str("role", role).Logger()
+
+	current, err := s.dbClient.OrganizationMembers.GetMember(ctx, organization_members.GetMemberParams{
+		OrganizationID: organizationId,
+		UserID:         user.ID,
+	})
+	isOwner := err == nil && current.Role == roleOwner
+	if err != nil && err != pgx.ErrNoRows {
+		logger.Err(err).Msg("Failed to get member")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	// admins manage admins and scanners, only owners can grant or take away ownership
+	if (role == roleOwner || isOwner) && callerRole != roleOwner {
+		errMsg := "Only owners can manage owners"
 		logger.Info().Msg(errMsg)
-		return openapi.Response(http.StatusBadRequest, errMsg), nil
+		return openapi.Response(http.StatusForbidden, errMsg), nil
+	}
+	if isOwner && role != roleOwner {
+		owners, err := s.dbClient.OrganizationMembers.CountOwners(ctx, organizationId)
+		if err != nil {
+			logger.Err(err).Msg("Failed to count owners")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		if owners <= 1 {
+			errMsg := "Organization must keep at least one owner"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusBadRequest, errMsg), nil
+		}
 	}
 
-	// found registration, create ticket credential
-	revocable := int64(0)
-	resp, err := s.issuerClient.GenerateSignedCredential(ctx, &issuer.GenerateSignedCredentialRequest{
-		Header: &issuer.Header{
-			Version: 1,
-			Type:    fmt.Sprintf("%d", unitCredentialTypeID),
-			Context: event.ContextID,
-			Id:      util.StringToUint248Hash(userEmail).String(),
-		},
-		Body: &issuer.Body{
-			Tp: &issuer.CredType{
-				TypeId:    fmt.Sprintf("%d", unitCredentialTypeID),
-				Revocable: &revocable,
-			},
-		},
-		Attachments: &issuer.AttachmentSet{
-			Attachments: map[string]string{"event_id": eventId},
-		},
-		ChainId:            uint64(s.issuerChainID),
-		IdentityCommitment: user.IdentityCommitment,                                          // ticket credential is issued to the email
-		ExpiredAt:          fmt.Sprint(time.Now().Add(ticketCredentialValidDuration).Unix()), // one year
+	member, err := s.dbClient.OrganizationMembers.UpsertMember(ctx, organization_members.UpsertMemberParams{
+		OrganizationID: organizationId,
+		UserID:         user.ID,
+		Role:           role,
 	})
 	if err != nil {
-		logger.Err(err).Msg("Failed to generate ticket credential")
+		logger.Err(err).Msg("Failed to save member")
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
 
-	logger.Info().Msg("Generated ticket credential")
-	return openapi.Response(http.StatusCreated, openapi.UnencryptedTicketCredential{
-		EventId:    eventId,
-		Credential: resp.GetSignedCred(),
-		IssuedAt:   time.Now(),
-		ExpireAt:   time.Now().Add(ticketCredentialValidDuration),
-	}), nil
+	logger.Info().Msg("Saved member")
+
+	return openapi.Response(http.StatusOK, MarshalOrganizationMember(member, user.Email)), nil
 }
 
-// EventsGet - Get list of events
-func (s *APIService) EventsGet(ctx context.Context) (openapi.ImplResponse, error) {
-	events, err := s.dbClient.Events.ListEvents(ctx)
+// OrganizationsOrganizationIdMembersUserIdDelete - Remove a member from an organization
+func (s *APIService) OrganizationsOrganizationIdMembersUserIdDelete(ctx context.Context, organizationId string, userId string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsOrganizationIdMembersUserIdDelete").Str("organizationID", organizationId).Str("memberID", userId).Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+	ctx = logger.WithContext(ctx)
+
+	callerRole, rej, err := s.authorizeOrganization(ctx, organizationId, roleAdmin)
 	if err != nil {
+		logger.Err(err).Msg("Failed to authorize user")
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
+	if rej != nil {
+		return openapi.Response(rej.status, rej.reason), nil
+	}
 
-	return openapi.Response(http.StatusOK, MarshalEvents(events)), nil
+	member, err := s.dbClient.OrganizationMembers.GetMember(ctx, organization_members.GetMemberParams{
+		OrganizationID: organizationId,
+		UserID:         userId,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			errMsg := "Member not found"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusNotFound, errMsg), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if member.Role == roleOwner {
+		if callerRole != roleOwner {
+			errMsg := "Only owners can manage owners"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusForbidden, errMsg), nil
+		}
+		owners, err := s.dbClient.OrganizationMembers.CountOwners(ctx, organizationId)
+		if err != nil {
+			logger.Err(err).Msg("Failed to count owners")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		if owners <= 1 {
+			errMsg := "Organization must keep at least one owner"
+			logger.Info().Msg(errMsg)
+			return openapi.Response(http.StatusBadRequest, errMsg), nil
+		}
+	}
+
+	if _, err := s.dbClient.OrganizationMembers.DeleteMember(ctx, organization_members.DeleteMemberParams{
+		OrganizationID: organizationId,
+		UserID:         userId,
+	}); err != nil {
+		logger.Err(err).Msg("Failed to delete member")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Msg("Removed member")
+
+	return openapi.Response(http.StatusNoContent, nil), nil
 }
 
-// HealthGet - Check the health of the API
-func (s *APIService) HealthGet(ctx context.Context) (openapi.ImplResponse, error) {
-	return openapi.Response(http.StatusOK, "OK"), nil
+// OrganizationsPost - Create an organization, the user becomes its owner
+func (s *APIService) OrganizationsPost(ctx context.Context, organizationInput openapi.OrganizationInput) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "OrganizationsPost").Str("email", util.GetUserEmailFromContext(ctx)).Logger()
+
+	name := strings.TrimSpace(organizationInput.Name)
+	if name == "" {
+		errMsg := "Organization name is required"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	tx, err := s.dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		logger.Err(err).Msg("Failed to begin transaction")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	defer tx.Rollback(ctx)
+	org, err := s.dbClient.Organizations.WithTx(tx).CreateOrganization(ctx, organizations.CreateOrganizationParams{
+		ID:   uuid.New().String(),
+		Name: name,
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to create organization")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if _, err := s.dbClient.OrganizationMembers.WithTx(tx).UpsertMember(ctx, organization_members.UpsertMemberParams{
+		OrganizationID: org.ID,
+		UserID:         util.GetUserIDFromContext(ctx),
+		Role:           roleOwner,
+	}); err != nil {
+		logger.Err(err).Msg("Failed to add owner")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		logger.Err(err).Msg("Failed to commit organization creation")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Str("organizationID", org.ID).Msg("Created organization")
+
+	return openapi.Response(http.StatusCreated, MarshalOrganization(org, roleOwner)), nil
 }
 
 // UserLoginPost - User login
@@ -424,9 +2072,52 @@ func (s *APIService) UserUpdatePut(ctx context.Context, userUpdate openapi.UserU
 		return openapi.Response(http.StatusInternalServerError, nil), err
 	}
 
+	// the update stands if queued credentials cannot be issued now, they are issued when the user fetches them
+	if user.IdentityCommitment != "" {
+		if _, err := s.issueQueuedCredentials(ctx, userEmail, user.IdentityCommitment); err != nil {
+			logger.Warn().Err(err).Msg("Failed to issue queued credentials")
+		}
+	}
+
 	return openapi.Response(200, user), nil
 }
 
+// UserMeCredentialsGet - Get the credentials issued to the user by event organizers
+func (s *APIService) UserMeCredentialsGet(ctx context.Context) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "UserMeCredentialsGet").Logger()
+	userEmail := util.GetUserEmailFromContext(ctx)
+	userID := util.GetUserIDFromContext(ctx)
+	if userID == "" || userEmail == "" {
+		return openapi.Response(http.StatusUnauthorized, nil), nil
+	}
+	logger = logger.With().Str("email", userEmail).Str("uid", userID).Logger()
+	ctx = logger.WithContext(ctx)
+
+	user, err := s.dbClient.Users.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Err(err).Msg("User not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	// credentials that could not be issued before are retried, the ones issued are listed regardless
+	if user.IdentityCommitment != "" {
+		if _, err := s.issueQueuedCredentials(ctx, userEmail, user.IdentityCommitment); err != nil {
+			logger.Warn().Err(err).Msg("Failed to issue queued credentials")
+		}
+	}
+
+	credentials, err := s.dbClient.EventCredentials.ListIssuedByEmail(ctx, userEmail)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get credentials")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	return openapi.Response(http.StatusOK, MarshalEventCredentials(credentials)), nil
+}
+
 func (s *APIService) UserMeEmailCredentialGet(ctx context.Context) (openapi.ImplResponse, error) {
 	logger := log.Ctx(ctx).With().Str("op", "UserMeEmailCredentialGet").Logger()
 	userEmail := util.GetUserEmailFromContext(ctx)
@@ -531,27 +2222,39 @@ func (s *APIService) UserMeRequestEmailCredentialPost(ctx context.Context) (open
 		return openapi.Response(http.StatusBadRequest, errMsg), nil
 	}
 
-	// issue email credential
+	// issue email credential, a unit credential that is never revoked
+	credentialType, err := s.getCredentialType(ctx, s.emailCredentialTypeID)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get email credential type")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	values, err := credentialType.claimValues(nil)
+	if err != nil {
+		logger.Err(err).Msg("Email credential type has claims")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	credType := credentialType.credType()
 	revocable := int64(0)
+	credType.Revocable = &revocable
+	issuedAt := time.Now()
+	expireAt := issuedAt.Add(emailCredentialValidDuration)
 	resp, err := s.issuerClient.GenerateSignedCredential(ctx, &issuer.GenerateSignedCredentialRequest{
 		Header: &issuer.Header{
 			Version: 1,
-			Type:    fmt.Sprintf("%d", unitCredentialTypeID),
+			Type:    credentialType.TypeID,
 			Context: fmt.Sprintf("%d", s.emailCredentialContextID),
 			Id:      util.StringToUint248Hash(userEmail).String(),
 		},
 		Body: &issuer.Body{
-			Tp: &issuer.CredType{
-				TypeId:    fmt.Sprintf("%d", unitCredentialTypeID),
-				Revocable: &revocable,
-			},
+			Tp:     credType,
+			Values: values,
 		},
 		Attachments: &issuer.AttachmentSet{
 			Attachments: map[string]string{"email": userEmail},
 		},
 		ChainId:            uint64(s.issuerChainID),
 		IdentityCommitment: user.IdentityCommitment,
-		ExpiredAt:          fmt.Sprint(time.Now().Add(emailCredentialValidDuration).Unix()),
+		ExpiredAt:          fmt.Sprint(expireAt.Unix()),
 	})
 	if err != nil {
 		logger.Err(err).Msg("Failed to generate email credential")
@@ -561,8 +2264,77 @@ func (s *APIService) UserMeRequestEmailCredentialPost(ctx context.Context) (open
 	logger.Info().Msg("Generated email credential")
 	return openapi.Response(http.StatusCreated, openapi.UnencryptedEmailCredential{
 		Credential: resp.GetSignedCred(),
-		IssuedAt:   time.Now(),
-		ExpireAt:   time.Now().Add(emailCredentialValidDuration),
+		IssuedAt:   issuedAt,
+		ExpireAt:   expireAt,
+	}), nil
+}
+
+// UserMeRequestEmailDomainCredentialPost - Generate a new email domain credential, with a hashed claim of the email domain so that membership of the domain can be proven without the address
+func (s *APIService) UserMeRequestEmailDomainCredentialPost(ctx context.Context) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx).With().Str("op", "UserMeRequestEmailDomainCredentialPost").Logger()
+	userEmail := util.GetUserEmailFromContext(ctx)
+	userID := util.GetUserIDFromContext(ctx)
+	if userID == "" || userEmail == "" {
+		return openapi.Response(http.StatusUnauthorized, nil), nil
+	}
+	logger = logger.With().Str("email", userEmail).Str("uid", userID).Logger()
+
+	// get user identity commitment
+	user, err := s.dbClient.Users.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			logger.Err(err).Msg("User not found")
+			return openapi.Response(http.StatusNotFound, nil), nil
+		}
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if user.IdentityCommitment == "" {
+		errMsg := "User identity commitment not set, cannot request email domain credential"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusBadRequest, errMsg), nil
+	}
+
+	// issue email domain credential, a credential of its own so that the email credential keeps its type
+	credentialType, err := s.getCredentialType(ctx, s.emailDomainCredentialTypeID)
+	if err != nil {
+		logger.Err(err).Msg("Failed to get email domain credential type")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	values, err := credentialType.claimValues([]string{emailDomain(userEmail)})
+	if err != nil {
+		logger.Err(err).Msg("Failed to build email domain claims")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	issuedAt := time.Now()
+	expireAt := issuedAt.Add(credentialType.defaultTTL())
+	resp, err := s.issuerClient.GenerateSignedCredential(ctx, &issuer.GenerateSignedCredentialRequest{
+		Header: &issuer.Header{
+			Version: 1,
+			Type:    credentialType.TypeID,
+			Context: fmt.Sprintf("%d", s.emailCredentialContextID),
+			Id:      util.StringToUint248Hash("domain:" + userEmail).String(),
+		},
+		Body: &issuer.Body{
+			Tp:     credentialType.credType(),
+			Values: values,
+		},
+		Attachments: &issuer.AttachmentSet{
+			Attachments: map[string]string{"email": userEmail},
+		},
+		ChainId:            uint64(s.issuerChainID),
+		IdentityCommitment: user.IdentityCommitment,
+		ExpiredAt:          fmt.Sprint(expireAt.Unix()),
+	})
+	if err != nil {
+		logger.Err(err).Msg("Failed to generate email domain credential")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	logger.Info().Msg("Generated email domain credential")
+	return openapi.Response(http.StatusCreated, openapi.UnencryptedEmailCredential{
+		Credential: resp.GetSignedCred(),
+		IssuedAt:   issuedAt,
+		ExpireAt:   expireAt,
 	}), nil
 }
 
@@ -0,0 +1,390 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/proof-pass/proof-pass/backend/repos/event_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/issued_tickets"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_credentials"
+	"github.com/proof-pass/proof-pass/backend/repos/ticket_issuance_jobs"
+	"github.com/proof-pass/proof-pass/backend/util"
+	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
+	"github.com/rs/zerolog/log"
+)
+
+// statuses of a ticket issuance job
+const (
+	ticketIssuanceStatusRunning   = "running"
+	ticketIssuanceStatusCompleted = "completed"
+	ticketIssuanceStatusFailed    = "failed"
+)
+
+const (
+	// ticketIssuanceBatchSize is the number of tickets signed by each call to the issuer
+	ticketIssuanceBatchSize = 50
+	// ticketIssuanceMaxAttempts is the number of times a ticket is signed before it is reported as failed
+	ticketIssuanceMaxAttempts = 3
+	// ticketIssuanceStaleAfter is how long a running job can go without progress before it is
+	// considered interrupted and another job can be started for the event
+	ticketIssuanceStaleAfter = 10 * time.Minute
+)
+
+// ticketIssuanceRetryDelay is the wait before the tickets that failed are signed again
+var ticketIssuanceRetryDelay = 5 * time.Second
+
+// ticketClaims returns the claim values of a ticket of the event, tiered tickets carry the tier of the registration
+func ticketClaims(event events.Event, tier string) []string {
+	if event.TieredTickets {
+		return []string{ticketTierValue(tier)}
+	}
+	return []string{}
+}
+
+// newTicketRequest builds the request signing a ticket of the event for the identity commitment
+func newTicketRequest(event events.Event, credentialType *credentialType, claims []string, credentialID string, chainID uint64, identityCommitment string, expireAt time.Time) (*issuer.GenerateSignedCredentialRequest, error) {
+	values, err := credentialType.claimValues(claims)
+	if err != nil {
+		return nil, err
+	}
+	return &issuer.GenerateSignedCredentialRequest{
+		Header: &issuer.Header{
+			Version: 1,
+			Type:    credentialType.TypeID,
+			Context: event.ContextID,
+			Id:      credentialID,
+		},
+		Body: &issuer.Body{
+			Tp:     credentialType.credType(),
+			Values: values,
+		},
+		Attachments: &issuer.AttachmentSet{
+			Attachments: map[string]string{"event_id": event.ID},
+		},
+		ChainId:            chainID,
+		IdentityCommitment: identityCommitment,
+		ExpiredAt:          fmt.Sprint(expireAt.Unix()),
+	}, nil
+}
+
+// pendingTicket is a ticket of an issuance job waiting to be signed
+type pendingTicket struct {
+	email        string
+	credentialID string
+	claims       []string
+	issuedAt     time.Time
+	expireAt     time.Time
+	request      *issuer.GenerateSignedCredentialRequest
+	attempts     int
+	err          string
+	finished     bool
+}
+
+// signTickets signs the tickets in batches. Tickets that fail are signed again in a later round, after
+// every other ticket, so a failing recipient does not hold up the rest. done is called once for each
+// ticket, with the signed credential, or with an empty one once the ticket has failed every attempt.
+// progress is called after each batch. Signing stops once the context is done, the tickets left are not
+// finished.
+func (s *APIService) signTickets(ctx context.Context, tickets []*pendingTicket, done func(ticket *pendingTicket, signedCred string), progress func()) {
+	for round := 0; len(tickets) > 0; round++ {
+		if round > 0 {
+			time.Sleep(ticketIssuanceRetryDelay)
+		}
+		var retry []*pendingTicket
+		for start := 0; start < len(tickets); start += ticketIssuanceBatchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			batch := tickets[start:min(start+ticketIssuanceBatchSize, len(tickets))]
+			requests := make([]*issuer.GenerateSignedCredentialRequest, len(batch))
+			for i, ticket := range batch {
+				requests[i] = ticket.request
+			}
+			// a failed call fails every ticket of the batch, they are retried like tickets that failed on their own
+			resp, err := s.issuerClient.GenerateSignedCredentials(ctx, &issuer.GenerateSignedCredentialsRequest{Requests: requests})
+			results := resp.GetResults()
+			for i, ticket := range batch {
+				ticket.attempts++
+				switch {
+				case err != nil:
+					ticket.err = err.Error()
+				case i >= len(results):
+					ticket.err = "no result from the issuer"
+				case results[i].GetError() != "":
+					ticket.err = results[i].GetError()
+				default:
+					ticket.finished = true
+					done(ticket, results[i].GetSignedCred())
+					continue
+				}
+				if ticket.attempts < ticketIssuanceMaxAttempts {
+					retry = append(retry, ticket)
+				} else {
+					ticket.finished = true
+					done(ticket, "")
+				}
+			}
+			progress()
+		}
+		tickets = retry
+	}
+}
+
+// pendingTickets records a ticket for each registrant of the event who can be issued one and returns them
+// with the number of registrants skipped. Registrants are skipped if they have no identity commitment yet,
+// already have a ticket, stored, pre-issued or issued and not revoked, or are not of an allowed email domain.
+// The tickets recorded are deleted again if preparing fails.
+func (s *APIService) pendingTickets(ctx context.Context, event events.Event) (_ []*pendingTicket, _ int, err error) {
+	credentialType, err := s.getCredentialType(ctx, event.TicketTypeID)
+	if err != nil {
+		return nil, 0, err
+	}
+	chainID, ok := parseChainID(event.ChainID)
+	if !ok {
+		return nil, 0, fmt.Errorf("event chain ID %q is invalid", event.ChainID)
+	}
+	issuedAt := time.Now()
+	expireAt := ticketExpiry(event, issuedAt)
+
+	registrations, err := s.dbClient.Registrations.GetEventRegistrations(ctx, event.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get registrations, %v", err)
+	}
+	preIssued, err := s.dbClient.EventCredentials.ListIssuedByEventIdAndTypeId(ctx, event_credentials.ListIssuedByEventIdAndTypeIdParams{
+		EventID: event.ID,
+		TypeID:  credentialType.TypeID,
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list pre-issued tickets, %v", err)
+	}
+	issued, err := s.dbClient.IssuedTickets.ListActiveByEventId(ctx, event.ID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list issued tickets, %v", err)
+	}
+	hasTicket := make(map[string]bool, len(preIssued)+len(issued))
+	for _, credential := range preIssued {
+		hasTicket[credential.Email] = true
+	}
+	for _, ticket := range issued {
+		hasTicket[ticket.Email] = true
+	}
+
+	var tickets []*pendingTicket
+	defer func() {
+		if err != nil {
+			for _, ticket := range tickets {
+				s.deleteUnsignedTicket(ctx, ticket)
+			}
+		}
+	}()
+	skipped := 0
+	for _, registration := range registrations {
+		if hasTicket[registration.Email] || !emailDomainAllowed(event, registration.Email) {
+			skipped++
+			continue
+		}
+		_, err := s.dbClient.TicketCredentials.GetByEventIdAndEmail(ctx, ticket_credentials.GetByEventIdAndEmailParams{
+			EventID: event.ID,
+			Email:   registration.Email,
+		})
+		if err == nil {
+			skipped++
+			continue
+		}
+		if err != pgx.ErrNoRows {
+			return nil, 0, fmt.Errorf("failed to get ticket credential, %v", err)
+		}
+		user, err := s.dbClient.Users.GetUserByEmail(ctx, registration.Email)
+		if err != nil && err != pgx.ErrNoRows {
+			return nil, 0, fmt.Errorf("failed to get user, %v", err)
+		}
+		if err == pgx.ErrNoRows || user.IdentityCommitment == "" {
+			skipped++
+			continue
+		}
+
+		credentialID, err := util.RandomUint248()
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to generate ticket ID, %v", err)
+		}
+		claims := ticketClaims(event, registration.Tier)
+		request, err := newTicketRequest(event, credentialType, claims, credentialID.String(), chainID, user.IdentityCommitment, expireAt)
+		if err != nil {
+			return nil, 0, err
+		}
+		// record the ticket before issuing it so that every issued ticket can be revoked, nothing is
+		// recorded if the registrant requested a ticket in the meantime
+		recorded, err := s.dbClient.IssuedTickets.CreateIssuedTicket(ctx, issued_tickets.CreateIssuedTicketParams{
+			CredentialID: credentialID.String(),
+			EventID:      event.ID,
+			Email:        registration.Email,
+		})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to record issued ticket, %v", err)
+		}
+		if recorded == 0 {
+			skipped++
+			continue
+		}
+		tickets = append(tickets, &pendingTicket{
+			email:        registration.Email,
+			credentialID: credentialID.String(),
+			claims:       claims,
+			issuedAt:     issuedAt,
+			expireAt:     expireAt,
+			request:      request,
+		})
+	}
+	return tickets, skipped, nil
+}
+
+// claimTicketIssuanceJob creates a running job for the event, returning false if one is already running. A job
+// that stopped reporting progress was interrupted and is failed first, so it no longer blocks a new one. The
+// event is locked while claiming, so that concurrent requests cannot both take over a stale job.
+func (s *APIService) claimTicketIssuanceJob(ctx context.Context, eventID string, now time.Time) (ticket_issuance_jobs.TicketIssuanceJob, bool, error) {
+	tx, err := s.dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		return ticket_issuance_jobs.TicketIssuanceJob{}, false, fmt.Errorf("failed to begin transaction, %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := s.dbClient.Events.WithTx(tx).LockEventByID(ctx, eventID); err != nil {
+		return ticket_issuance_jobs.TicketIssuanceJob{}, false, fmt.Errorf("failed to lock event, %v", err)
+	}
+	if _, err := s.dbClient.TicketIssuanceJobs.WithTx(tx).FailStaleJobs(ctx, ticket_issuance_jobs.FailStaleJobsParams{
+		EventID:     eventID,
+		StaleBefore: pgtype.Timestamptz{Time: now.Add(-ticketIssuanceStaleAfter), Valid: true},
+	}); err != nil {
+		return ticket_issuance_jobs.TicketIssuanceJob{}, false, fmt.Errorf("failed to fail stale jobs, %v", err)
+	}
+	job, err := s.dbClient.TicketIssuanceJobs.WithTx(tx).CreateJob(ctx, ticket_issuance_jobs.CreateJobParams{
+		ID:      uuid.New().String(),
+		EventID: eventID,
+	})
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return ticket_issuance_jobs.TicketIssuanceJob{}, false, nil
+		}
+		return ticket_issuance_jobs.TicketIssuanceJob{}, false, fmt.Errorf("failed to create job, %v", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return ticket_issuance_jobs.TicketIssuanceJob{}, false, fmt.Errorf("failed to commit transaction, %v", err)
+	}
+	return job, true, nil
+}
+
+// runTicketIssuanceJob pre-issues tickets to the registrants of the event and records the progress of the job.
+// Registrants pick up their ticket with the credentials issued to them by organizers. The job stops once it
+// is no longer running, such as when it was failed as stale and another job was started.
+func (s *APIService) runTicketIssuanceJob(ctx context.Context, job ticket_issuance_jobs.TicketIssuanceJob, event events.Event) {
+	logger := log.Ctx(ctx).With().Str("op", "runTicketIssuanceJob").Str("jobID", job.ID).Str("eventID", event.ID).Logger()
+	ctx = logger.WithContext(ctx)
+	signCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	tickets, skipped, err := s.pendingTickets(ctx, event)
+	if err != nil {
+		logger.Err(err).Msg("Failed to prepare tickets")
+		s.finishTicketIssuanceJob(ctx, job.ID, ticketIssuanceStatusFailed, "Failed to prepare tickets")
+		return
+	}
+
+	progress := ticket_issuance_jobs.UpdateProgressParams{
+		ID:      job.ID,
+		Total:   int32(len(tickets) + skipped),
+		Skipped: int32(skipped),
+	}
+	updateProgress := func() {
+		updated, err := s.dbClient.TicketIssuanceJobs.UpdateProgress(ctx, progress)
+		if err != nil {
+			logger.Warn().Err(err).Msg("Failed to update job progress")
+			return
+		}
+		if updated == 0 {
+			logger.Warn().Msg("Job is no longer running, stopping")
+			stop()
+		}
+	}
+	updateProgress()
+
+	s.signTickets(signCtx, tickets, func(ticket *pendingTicket, signedCred string) {
+		ticketLogger := logger.With().Str("attendeeEmail", ticket.email).Int("attempts", ticket.attempts).Logger()
+		if signedCred == "" {
+			ticketLogger.Warn().Str("error", ticket.err).Msg("Failed to issue ticket")
+			s.deleteUnsignedTicket(ctx, ticket)
+			progress.Failed++
+			return
+		}
+		if err := s.storePreIssuedTicket(ctx, event, ticket, signedCred); err != nil {
+			ticketLogger.Err(err).Msg("Failed to store ticket")
+			// the signed ticket is lost, it is revoked so that the registrant can be issued another
+			if err := s.dbClient.IssuedTickets.RevokeByCredentialID(ctx, issued_tickets.RevokeByCredentialIDParams{
+				CredentialID:     ticket.credentialID,
+				RevocationReason: revocationReasonNotStored,
+			}); err != nil {
+				ticketLogger.Err(err).Msg("Failed to revoke ticket that was not stored")
+			}
+			progress.Failed++
+			return
+		}
+		progress.Issued++
+	}, updateProgress)
+
+	// tickets left when the job stopped were recorded but never signed, they are issued by the next job
+	for _, ticket := range tickets {
+		if !ticket.finished {
+			s.deleteUnsignedTicket(ctx, ticket)
+		}
+	}
+	if signCtx.Err() != nil {
+		return
+	}
+
+	s.finishTicketIssuanceJob(ctx, job.ID, ticketIssuanceStatusCompleted, "")
+	logger.Info().Int32("total", progress.Total).Int32("issued", progress.Issued).Int32("skipped", progress.Skipped).Int32("failed", progress.Failed).Msg("Finished ticket issuance job")
+}
+
+// storePreIssuedTicket stores a signed ticket for the registrant to pick up and counts it for event stats
+func (s *APIService) storePreIssuedTicket(ctx context.Context, event events.Event, ticket *pendingTicket, signedCred string) error {
+	if err := s.dbClient.EventCredentials.CreateIssuedCredential(ctx, event_credentials.CreateIssuedCredentialParams{
+		EventID:      event.ID,
+		Email:        ticket.email,
+		TypeID:       ticket.request.GetHeader().GetType(),
+		ClaimValues:  ticket.claims,
+		CredentialID: pgtype.Text{String: ticket.credentialID, Valid: true},
+		Credential:   pgtype.Text{String: signedCred, Valid: true},
+		IssuedAt:     pgtype.Timestamptz{Time: ticket.issuedAt, Valid: true},
+		ExpireAt:     pgtype.Timestamptz{Time: ticket.expireAt, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to store ticket, %v", err)
+	}
+	// the ticket has been issued, a failure to count it is not returned
+	if err := s.dbClient.TicketIssuances.CreateOne(ctx, event.ID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Failed to record ticket issuance")
+	}
+	return nil
+}
+
+// deleteUnsignedTicket deletes the record of a ticket that was never signed, so that the registrant can be
+// issued one again. A failure is only logged.
+func (s *APIService) deleteUnsignedTicket(ctx context.Context, ticket *pendingTicket) {
+	if err := s.dbClient.IssuedTickets.DeleteByCredentialID(ctx, ticket.credentialID); err != nil {
+		log.Ctx(ctx).Warn().Err(err).Str("attendeeEmail", ticket.email).Msg("Failed to delete unsigned ticket")
+	}
+}
+
+// finishTicketIssuanceJob records the final status of the job, the job is reported as interrupted if it cannot
+func (s *APIService) finishTicketIssuanceJob(ctx context.Context, jobID string, status string, reason string) {
+	if err := s.dbClient.TicketIssuanceJobs.FinishJob(ctx, ticket_issuance_jobs.FinishJobParams{
+		ID:     jobID,
+		Status: status,
+		Error:  reason,
+	}); err != nil {
+		log.Ctx(ctx).Err(err).Msg("Failed to finish ticket issuance job")
+	}
+}
@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/proof-pass/proof-pass/backend/repos/credential_types"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/issuer/api/go/issuer/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeBatchIssuerClient signs each credential as its ID, after failing it the given number of times
+type fakeBatchIssuerClient struct {
+	issuer.IssuerServiceClient
+	failures  map[string]int
+	callErrs  int
+	batchSize []int
+}
+
+func (c *fakeBatchIssuerClient) GenerateSignedCredentials(ctx context.Context, in *issuer.GenerateSignedCredentialsRequest, opts ...grpc.CallOption) (*issuer.GenerateSignedCredentialsResponse, error) {
+	c.batchSize = append(c.batchSize, len(in.Requests))
+	if c.callErrs > 0 {
+		c.callErrs--
+		return nil, errors.New("issuer unavailable")
+	}
+	results := make([]*issuer.GenerateSignedCredentialResult, len(in.Requests))
+	for i, request := range in.Requests {
+		id := request.GetHeader().GetId()
+		if c.failures[id] > 0 {
+			c.failures[id]--
+			results[i] = &issuer.GenerateSignedCredentialResult{Error: "failed to sign " + id}
+			continue
+		}
+		results[i] = &issuer.GenerateSignedCredentialResult{SignedCred: id}
+	}
+	return &issuer.GenerateSignedCredentialsResponse{Results: results}, nil
+}
+
+func newPendingTickets(n int) []*pendingTicket {
+	tickets := make([]*pendingTicket, n)
+	for i := range tickets {
+		id := fmt.Sprint(i)
+		tickets[i] = &pendingTicket{
+			credentialID: id,
+			request:      &issuer.GenerateSignedCredentialRequest{Header: &issuer.Header{Id: id}},
+		}
+	}
+	return tickets
+}
+
+func TestSignTickets(t *testing.T) {
+	ticketIssuanceRetryDelay = 0
+	client := &fakeBatchIssuerClient{failures: map[string]int{
+		"1":  1,                         // signed on retry
+		"60": ticketIssuanceMaxAttempts, // fails every attempt
+	}}
+	apiService := &APIService{issuerClient: client}
+
+	signed := make(map[string]string)
+	var failed []*pendingTicket
+	batches := 0
+	apiService.signTickets(context.Background(), newPendingTickets(ticketIssuanceBatchSize+20), func(ticket *pendingTicket, signedCred string) {
+		if signedCred == "" {
+			failed = append(failed, ticket)
+			return
+		}
+		signed[ticket.credentialID] = signedCred
+	}, func() { batches++ })
+
+	assert.Len(t, signed, ticketIssuanceBatchSize+19)
+	assert.Equal(t, "1", signed["1"])
+	require.Len(t, failed, 1)
+	assert.Equal(t, "60", failed[0].credentialID)
+	assert.Equal(t, ticketIssuanceMaxAttempts, failed[0].attempts)
+	assert.Equal(t, "failed to sign 60", failed[0].err)
+
+	// the failing tickets are retried together once every other ticket has been signed
+	assert.Equal(t, []int{ticketIssuanceBatchSize, 20, 2, 1}, client.batchSize)
+	assert.Equal(t, 4, batches)
+}
+
+func TestSignTickets_CallError(t *testing.T) {
+	ticketIssuanceRetryDelay = 0
+	client := &fakeBatchIssuerClient{callErrs: 1}
+	apiService := &APIService{issuerClient: client}
+
+	signed := 0
+	apiService.signTickets(context.Background(), newPendingTickets(3), func(ticket *pendingTicket, signedCred string) {
+		assert.NotEmpty(t, signedCred)
+		assert.Equal(t, 2, ticket.attempts)
+		signed++
+	}, func() {})
+
+	assert.Equal(t, 3, signed)
+}
+
+func TestNewTicketRequest(t *testing.T) {
+	expireAt := time.Now().Add(time.Hour)
+
+	unit, err := newCredentialType(credential_types.CredentialType{TypeID: "1", Claims: []byte(`[]`)})
+	require.NoError(t, err)
+	event := events.Event{ID: "event", ContextID: "42"}
+	request, err := newTicketRequest(event, unit, ticketClaims(event, ticketTierVIP), "7", 1, "123", expireAt)
+	require.NoError(t, err)
+	assert.Equal(t, "1", request.Header.Type)
+	assert.Equal(t, "42", request.Header.Context)
+	assert.Equal(t, "7", request.Header.Id)
+	assert.Equal(t, "event", request.Attachments.Attachments["event_id"])
+	assert.Equal(t, "123", request.IdentityCommitment)
+	assert.Equal(t, fmt.Sprint(expireAt.Unix()), request.ExpiredAt)
+	assert.Empty(t, request.Body.Values)
+
+	// tiered tickets carry the tier of the registration
+	scalar, err := newCredentialType(credential_types.CredentialType{TypeID: "2", Claims: []byte(`[{"name": "val", "type": "scalar", "width": 256}]`)})
+	require.NoError(t, err)
+	tiered := events.Event{ID: "event", ContextID: "42", TieredTickets: true}
+	request, err = newTicketRequest(tiered, scalar, ticketClaims(tiered, ticketTierVIP), "7", 1, "123", expireAt)
+	require.NoError(t, err)
+	require.Len(t, request.Body.Values, 1)
+	assert.Equal(t, ticketTierValue(ticketTierVIP), request.Body.Values[0].GetScalarValue().GetValue())
+}
+
+func TestSignTickets_Stopped(t *testing.T) {
+	ticketIssuanceRetryDelay = 0
+	apiService := &APIService{issuerClient: &fakeBatchIssuerClient{}}
+	ctx, stop := context.WithCancel(context.Background())
+
+	tickets := newPendingTickets(ticketIssuanceBatchSize + 20)
+	signed := 0
+	apiService.signTickets(ctx, tickets, func(ticket *pendingTicket, signedCred string) {
+		signed++
+	}, stop)
+
+	assert.Equal(t, ticketIssuanceBatchSize, signed)
+	assert.True(t, tickets[0].finished)
+	assert.False(t, tickets[ticketIssuanceBatchSize].finished)
+}
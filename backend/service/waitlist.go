@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/repos/events"
+	"github.com/proof-pass/proof-pass/backend/repos/registrations"
+	"github.com/proof-pass/proof-pass/backend/repos/waitlist"
+	"github.com/rs/zerolog/log"
+)
+
+// fillFromWaitlist registers people from the waitlist, in the order they joined, until the event is full.
+// The event row is locked for the duration, so concurrent calls give out each free place only once.
+// Promoted people are emailed once the registrations are committed, a failed email is only logged.
+func (s *APIService) fillFromWaitlist(ctx context.Context, eventID string) ([]string, error) {
+	tx, err := s.dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction, %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	event, err := s.dbClient.Events.WithTx(tx).LockEventByID(ctx, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock event, %v", err)
+	}
+	promoted, err := s.promoteFromWaitlist(ctx, tx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit waitlist promotion, %v", err)
+	}
+	s.notifyPromoted(ctx, event, promoted)
+	return promoted, nil
+}
+
+// joinWaitlist puts the email on the waitlist of the event and fills the free places from it, returning
+// its position on the waitlist, or 0 if it was registered. The position is read under the same lock as
+// the promotion, so that a concurrent promotion cannot move the email after it was read.
+func (s *APIService) joinWaitlist(ctx context.Context, eventID string, email string) (int64, error) {
+	tx, err := s.dbClient.DBConnPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction, %v", err)
+	}
+	defer tx.Rollback(ctx)
+
+	event, err := s.dbClient.Events.WithTx(tx).LockEventByID(ctx, eventID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to lock event, %v", err)
+	}
+	// joining twice keeps the original place in the queue
+	if _, err := s.dbClient.Waitlist.WithTx(tx).AddToWaitlist(ctx, waitlist.AddToWaitlistParams{
+		EventID: eventID,
+		Email:   email,
+	}); err != nil && err != pgx.ErrNoRows {
+		return 0, fmt.Errorf("failed to join waitlist, %v", err)
+	}
+	promoted, err := s.promoteFromWaitlist(ctx, tx, event)
+	if err != nil {
+		return 0, err
+	}
+	var position int64
+	if !slices.Contains(promoted, email) {
+		position, err = s.dbClient.Waitlist.WithTx(tx).GetPosition(ctx, waitlist.GetPositionParams{
+			EventID: eventID,
+			Email:   email,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get waitlist position, %v", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit waitlist, %v", err)
+	}
+	s.notifyPromoted(ctx, event, promoted)
+	return position, nil
+}
+
+// promoteFromWaitlist registers people from the waitlist until the event is full, within the transaction
+// holding the lock of the event
+func (s *APIService) promoteFromWaitlist(ctx context.Context, tx pgx.Tx, event events.Event) ([]string, error) {
+	if !event.Capacity.Valid {
+		return nil, nil
+	}
+	registered, err := s.dbClient.Registrations.WithTx(tx).CountEventRegistrations(ctx, event.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count registrations, %v", err)
+	}
+
+	var promoted []string
+	for registered < int64(event.Capacity.Int32) {
+		next, err := s.dbClient.Waitlist.WithTx(tx).PopNext(ctx, event.ID)
+		if err == pgx.ErrNoRows {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get next on the waitlist, %v", err)
+		}
+		// people registered another way since joining the waitlist do not take a second place
+		_, err = s.dbClient.Registrations.WithTx(tx).CreateOrIgnoreRegistration(ctx, registrations.CreateOrIgnoreRegistrationParams{
+			EventID: event.ID,
+			Email:   next.Email,
+		})
+		if err == pgx.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to register from the waitlist, %v", err)
+		}
+		registered++
+		promoted = append(promoted, next.Email)
+	}
+	return promoted, nil
+}
+
+// notifyPromoted emails the people promoted from the waitlist, a failed email is only logged
+func (s *APIService) notifyPromoted(ctx context.Context, event events.Event, promoted []string) {
+	logger := log.Ctx(ctx)
+	for _, email := range promoted {
+		if err := s.sendWaitlistPromotionEmail(ctx, email, event.Name); err != nil {
+			logger.Warn().Err(err).Str("eventID", event.ID).Str("email", email).Msg("Failed to notify promotion from the waitlist")
+		}
+	}
+	if len(promoted) > 0 {
+		logger.Info().Str("eventID", event.ID).Int("promoted", len(promoted)).Msg("Registered people from the waitlist")
+	}
+}
+
+// registerOrWaitlist registers the signed in user or the email of a webhook order, or puts them on the
+// waitlist if the event is full. They join the waitlist first and are registered from it, so they do not
+// take a place from people who are already waiting.
+func (s *APIService) registerOrWaitlist(ctx context.Context, event events.Event, email string) (openapi.ImplResponse, error) {
+	logger := log.Ctx(ctx)
+
+	_, err := s.dbClient.Registrations.GetOneByEventIdAndEmail(ctx, registrations.GetOneByEventIdAndEmailParams{
+		EventID: event.ID,
+		Email:   email,
+	})
+	if err == nil {
+		errMsg := "User is already registered for this event"
+		logger.Info().Msg(errMsg)
+		return openapi.Response(http.StatusConflict, errMsg), nil
+	}
+	if err != pgx.ErrNoRows {
+		logger.Err(err).Msg("Failed to get registration")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+
+	if !event.Capacity.Valid {
+		if _, err := s.dbClient.Registrations.CreateOrIgnoreRegistration(ctx, registrations.CreateOrIgnoreRegistrationParams{
+			EventID: event.ID,
+			Email:   email,
+		}); err != nil && err != pgx.ErrNoRows {
+			logger.Err(err).Msg("Failed to create registration")
+			return openapi.Response(http.StatusInternalServerError, nil), err
+		}
+		logger.Info().Msg("Registered")
+		return openapi.Response(http.StatusOK, openapi.WaitlistStatus{Status: waitlistStatusRegistered}), nil
+	}
+
+	position, err := s.joinWaitlist(ctx, event.ID, email)
+	if err != nil {
+		logger.Err(err).Msg("Failed to join waitlist")
+		return openapi.Response(http.StatusInternalServerError, nil), err
+	}
+	if position == 0 {
+		logger.Info().Msg("Registered from the waitlist")
+		return openapi.Response(http.StatusOK, openapi.WaitlistStatus{Status: waitlistStatusRegistered}), nil
+	}
+
+	logger.Info().Int64("position", position).Msg("Joined waitlist")
+
+	return openapi.Response(http.StatusOK, openapi.WaitlistStatus{
+		Status:   waitlistStatusWaitlisted,
+		Position: int32(position),
+	}), nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"io"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/jackc/pgx/v5"
+	"github.com/proof-pass/proof-pass/backend/openapi"
+	"github.com/proof-pass/proof-pass/backend/webhook"
+	"github.com/rs/zerolog/log"
+)
+
+const maxWebhookBodySize = 1 << 20
+
+const (
+	webhookStatusRegistered   = "registered"
+	webhookStatusWaitlisted   = "waitlisted"
+	webhookStatusUnregistered = "unregistered"
+	webhookStatusIgnored      = "ignored"
+)
+
+// ReceiveWebhook creates or removes a registration for an order placed on an external ticketing platform.
+// Each integration has its own URL and secret, so only the platform account it was set up on can send
+// orders to its event. The signature covers the raw body, so the request is not decoded by the generated
+// router.
+func (s *APIService) ReceiveWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	providerName := mux.Vars(r)["provider"]
+	integrationID := mux.Vars(r)["integrationId"]
+	logger := log.Ctx(ctx).With().Str("op", "ReceiveWebhook").Str("provider", providerName).Str("integrationID", integrationID).Logger()
+
+	provider := webhook.GetProvider(providerName)
+	if provider == nil {
+		logger.Info().Msg("Unknown webhook provider")
+		http.Error(w, "Unknown provider", http.StatusNotFound)
+		return
+	}
+	integration, err := s.dbClient.EventIntegrations.GetIntegrationByID(ctx, integrationID)
+	if err != nil && err != pgx.ErrNoRows {
+		logger.Err(err).Msg("Failed to get event integration")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if err == pgx.ErrNoRows || integration.Provider != providerName {
+		logger.Info().Msg("Unknown integration")
+		http.Error(w, "Unknown integration", http.StatusNotFound)
+		return
+	}
+	logger = logger.With().Str("eventID", integration.EventID).Logger()
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		logger.Info().Err(err).Msg("Failed to read webhook body")
+		http.Error(w, "Invalid body", http.StatusBadRequest)
+		return
+	}
+	if err := provider.Verify(r.Header, body, []byte(integration.Secret), time.Now()); err != nil {
+		logger.Info().Err(err).Msg("Rejected webhook")
+		http.Error(w, "Invalid signature", http.StatusUnauthorized)
+		return
+	}
+	order, err := provider.Parse(body)
+	if err != nil {
+		logger.Info().Err(err).Msg("Rejected webhook")
+		http.Error(w, "Invalid payload", http.StatusBadRequest)
+		return
+	}
+	logger = logger.With().Str("orderType", string(order.Type)).Str("orderID", order.OrderID).Str("externalEventID", order.ExternalEventID).Logger()
+
+	// orders for other events of the platform account are acknowledged so the platform does not retry them
+	if order.ExternalEventID != integration.ExternalEventID {
+		logger.Info().Msg("Order is not for the linked external event, ignoring order")
+		writeWebhookStatus(w, webhookStatusIgnored)
+		return
+	}
+
+	email, err := mail.ParseAddress(order.Email)
+	if err != nil {
+		logger.Info().Msg("Invalid email, ignoring order")
+		writeWebhookStatus(w, webhookStatusIgnored)
+		return
+	}
+
+	switch order.Type {
+	case webhook.OrderCreated:
+		event, err := s.dbClient.Events.GetEventByID(ctx, integration.EventID)
+		if err != nil {
+			logger.Err(err).Msg("Failed to get event")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		// orders placed once registration has closed are acknowledged without registering anyone
+		if eventClosed(event) || registrationClosed(event, time.Now()) {
+			logger.Info().Str("email", email.Address).Msg("Registration has closed, ignoring order")
+			writeWebhookStatus(w, webhookStatusIgnored)
+			return
+		}
+
+		// orders take a place like any other registration, or join the waitlist if the event is full
+		orderLogger := logger.With().Str("email", email.Address).Logger()
+		resp, err := s.registerOrWaitlist(orderLogger.WithContext(ctx), event, email.Address)
+		if err != nil {
+			logger.Err(err).Msg("Failed to register from webhook")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		// a retried order finds the registration it created
+		if resp.Code == http.StatusConflict {
+			writeWebhookStatus(w, webhookStatusRegistered)
+			return
+		}
+		if status, ok := resp.Body.(openapi.WaitlistStatus); ok && status.Status == waitlistStatusWaitlisted {
+			orderLogger.Info().Msg("Waitlisted from webhook")
+			writeWebhookStatus(w, webhookStatusWaitlisted)
+			return
+		}
+		orderLogger.Info().Msg("Registered from webhook")
+		writeWebhookStatus(w, webhookStatusRegistered)
+	case webhook.OrderCancelled:
+		if _, err := s.cancelRegistration(ctx, integration.EventID, email.Address, revocationReasonCancelled); err != nil {
+			logger.Err(err).Msg("Failed to cancel registration")
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		logger.Info().Str("email", email.Address).Msg("Unregistered from webhook")
+		writeWebhookStatus(w, webhookStatusUnregistered)
+	}
+}
+
+func writeWebhookStatus(w http.ResponseWriter, status string) {
+	code := http.StatusOK
+	_ = openapi.EncodeJSONResponse(map[string]string{"status": status}, &code, w)
+}
@@ -7,6 +7,8 @@ type ContextKey string
 const (
 	UserIDContextKey    ContextKey = "userID"
 	UserEmailContextKey ContextKey = "userEmail"
+	ScannerIDContextKey ContextKey = "scannerID"
+	EventIDContextKey   ContextKey = "eventID"
 )
 
 func SetUserIDInContext(ctx context.Context, userID string) context.Context {
@@ -32,3 +34,28 @@ func GetUserEmailFromContext(ctx context.Context) string {
 	}
 	return userEmail.(string)
 }
+
+func SetScannerIDInContext(ctx context.Context, scannerID string) context.Context {
+	return context.WithValue(ctx, ScannerIDContextKey, scannerID)
+}
+
+func SetEventIDInContext(ctx context.Context, eventID string) context.Context {
+	return context.WithValue(ctx, EventIDContextKey, eventID)
+}
+
+func GetScannerIDFromContext(ctx context.Context) string {
+	scannerID := ctx.Value(ScannerIDContextKey)
+	if scannerID == nil {
+		return ""
+	}
+	return scannerID.(string)
+}
+
+// GetEventIDFromContext returns the event a scanner token is scoped to
+func GetEventIDFromContext(ctx context.Context) string {
+	eventID := ctx.Value(EventIDContextKey)
+	if eventID == nil {
+		return ""
+	}
+	return eventID.(string)
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
 	"math/big"
 )
@@ -17,3 +18,17 @@ func StringToUint248Hash(input string) *big.Int {
 
 	return uint248Value
 }
+
+// RandomUint248 returns a random non-zero 248 bit number, used as a credential ID that is not derived from the holder
+func RandomUint248() (*big.Int, error) {
+	buf := make([]byte, 31)
+	for {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		value := new(big.Int).SetBytes(buf)
+		if value.Sign() != 0 {
+			return value, nil
+		}
+	}
+}
@@ -3,3 +3,7 @@ package util
 func GetUserEmailSigninCodeCacheKey(email string) string {
 	return "sign_in_code:" + email
 }
+
+func GetAttendanceStreamTicketCacheKey(ticket string) string {
+	return "attendance_stream_ticket:" + ticket
+}
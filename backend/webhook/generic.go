@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	genericSignatureHeader = "X-Webhook-Signature"
+	genericTimestampHeader = "X-Webhook-Timestamp"
+)
+
+// genericProvider accepts a simple JSON payload, signed with HMAC-SHA256 over "<timestamp>.<body>":
+//
+//	X-Webhook-Timestamp: 1719792000
+//	X-Webhook-Signature: sha256=<hex encoded HMAC>
+//
+//	{"type": "order.created", "order_id": "1", "event_id": "external-event", "email": "alice@example.com"}
+type genericProvider struct{}
+
+type genericPayload struct {
+	Type    string `json:"type"`
+	OrderID string `json:"order_id"`
+	EventID string `json:"event_id"`
+	Email   string `json:"email"`
+}
+
+func (genericProvider) Verify(header http.Header, body []byte, secret []byte, now time.Time) error {
+	timestamp := header.Get(genericTimestampHeader)
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	signedAt := time.Unix(sec, 0)
+	if signedAt.Before(now.Add(-signatureTolerance)) || signedAt.After(now.Add(signatureTolerance)) {
+		return ErrInvalidSignature
+	}
+
+	signature, err := hex.DecodeString(strings.TrimPrefix(header.Get(genericSignatureHeader), "sha256="))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal(signature, genericSignature(secret, timestamp, body)) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (genericProvider) Parse(body []byte) (*Order, error) {
+	var payload genericPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, ErrInvalidPayload
+	}
+	orderType := OrderType(payload.Type)
+	if orderType != OrderCreated && orderType != OrderCancelled {
+		return nil, ErrInvalidPayload
+	}
+	if payload.EventID == "" || payload.Email == "" {
+		return nil, ErrInvalidPayload
+	}
+	return &Order{
+		Type:            orderType,
+		OrderID:         payload.OrderID,
+		ExternalEventID: payload.EventID,
+		Email:           payload.Email,
+	}, nil
+}
+
+func genericSignature(secret []byte, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func signedHeader(secret []byte, signedAt time.Time, body []byte) http.Header {
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	header := http.Header{}
+	header.Set(genericTimestampHeader, timestamp)
+	header.Set(genericSignatureHeader, "sha256="+hex.EncodeToString(genericSignature(secret, timestamp, body)))
+	return header
+}
+
+func TestGenericVerify(t *testing.T) {
+	provider := GetProvider("generic")
+	secret := []byte("secret")
+	body := []byte(`{"type":"order.created","event_id":"1","email":"alice@example.com"}`)
+	now := time.Now()
+
+	assert.NoError(t, provider.Verify(signedHeader(secret, now, body), body, secret, now))
+	assert.ErrorIs(t, provider.Verify(signedHeader([]byte("other"), now, body), body, secret, now), ErrInvalidSignature)
+	assert.ErrorIs(t, provider.Verify(signedHeader(secret, now, body), []byte("{}"), secret, now), ErrInvalidSignature)
+	assert.ErrorIs(t, provider.Verify(signedHeader(secret, now.Add(-time.Hour), body), body, secret, now), ErrInvalidSignature)
+	assert.ErrorIs(t, provider.Verify(http.Header{}, body, secret, now), ErrInvalidSignature)
+}
+
+func TestGenericParse(t *testing.T) {
+	provider := GetProvider("generic")
+
+	order, err := provider.Parse([]byte(`{"type":"order.cancelled","order_id":"7","event_id":"1","email":"alice@example.com"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &Order{Type: OrderCancelled, OrderID: "7", ExternalEventID: "1", Email: "alice@example.com"}, order)
+
+	_, err = provider.Parse([]byte(`{"type":"order.refunded","event_id":"1","email":"alice@example.com"}`))
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+	_, err = provider.Parse([]byte(`{"type":"order.created","email":"alice@example.com"}`))
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+	_, err = provider.Parse([]byte(`not json`))
+	assert.ErrorIs(t, err, ErrInvalidPayload)
+}
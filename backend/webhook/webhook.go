@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// OrderType is what happened to an order on the ticketing platform
+type OrderType string
+
+const (
+	OrderCreated   OrderType = "order.created"
+	OrderCancelled OrderType = "order.cancelled"
+)
+
+// signatureTolerance is how old a signed request can be before it is rejected as a replay
+const signatureTolerance = 5 * time.Minute
+
+var (
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidPayload   = errors.New("invalid webhook payload")
+)
+
+// Order is an order of a ticket on an external ticketing platform
+type Order struct {
+	Type            OrderType
+	OrderID         string
+	ExternalEventID string
+	Email           string
+}
+
+// Provider verifies and parses the webhooks of a ticketing platform
+type Provider interface {
+	// Verify checks that the request was signed with the secret shared with the platform
+	Verify(header http.Header, body []byte, secret []byte, now time.Time) error
+	// Parse reads the order from the request body
+	Parse(body []byte) (*Order, error)
+}
+
+// providers are looked up by the name in the webhook URL
+var providers = map[string]Provider{
+	"generic": genericProvider{},
+}
+
+// GetProvider returns the provider with the given name, or nil if there is none
+func GetProvider(name string) Provider {
+	return providers[name]
+}
@@ -15,35 +15,121 @@
 
 import * as runtime from '../runtime';
 import type {
+  Attendance,
+  AttendanceStreamTicket,
+  BatchAttendanceRequest,
+  BatchAttendanceResponse,
+  CredentialIssuanceReport,
+  CredentialIssuanceRequest,
+  CredentialType,
+  CredentialTypeInput,
   EmailCredential,
   Event,
+  EventCredential,
+  EventInput,
+  EventIntegration,
+  EventIntegrationInput,
+  EventManifest,
+  EventStats,
   LoginResponse,
+  Organization,
+  OrganizationInput,
+  OrganizationMember,
+  OrganizationMemberInput,
   PutEmailCredentialRequest,
   PutTicketCredentialRequest,
   RecordAttendanceRequest,
+  RegisterScannerRequest,
+  Registration,
+  RegistrationImportReport,
+  RegistrationImportRequest,
+  RegistrationInput,
+  RevokedTicket,
+  Scanner,
+  ScannerCredential,
   TicketCredential,
+  TicketIssuanceJob,
+  TicketRevocationRequest,
   UnencryptedEmailCredential,
   UnencryptedTicketCredential,
   User,
   UserEmailVerificationRequest,
   UserLogin,
   UserUpdate,
+  WaitlistEntry,
+  WaitlistStatus,
 } from '../models/index';
 import {
+    AttendanceFromJSON,
+    AttendanceToJSON,
+    AttendanceStreamTicketFromJSON,
+    AttendanceStreamTicketToJSON,
+    BatchAttendanceRequestFromJSON,
+    BatchAttendanceRequestToJSON,
+    BatchAttendanceResponseFromJSON,
+    BatchAttendanceResponseToJSON,
+    CredentialIssuanceReportFromJSON,
+    CredentialIssuanceReportToJSON,
+    CredentialIssuanceRequestFromJSON,
+    CredentialIssuanceRequestToJSON,
+    CredentialTypeFromJSON,
+    CredentialTypeToJSON,
+    CredentialTypeInputFromJSON,
+    CredentialTypeInputToJSON,
     EmailCredentialFromJSON,
     EmailCredentialToJSON,
     EventFromJSON,
     EventToJSON,
+    EventCredentialFromJSON,
+    EventCredentialToJSON,
+    EventInputFromJSON,
+    EventInputToJSON,
+    EventIntegrationFromJSON,
+    EventIntegrationToJSON,
+    EventIntegrationInputFromJSON,
+    EventIntegrationInputToJSON,
+    EventManifestFromJSON,
+    EventManifestToJSON,
+    EventStatsFromJSON,
+    EventStatsToJSON,
     LoginResponseFromJSON,
     LoginResponseToJSON,
+    OrganizationFromJSON,
+    OrganizationToJSON,
+    OrganizationInputFromJSON,
+    OrganizationInputToJSON,
+    OrganizationMemberFromJSON,
+    OrganizationMemberToJSON,
+    OrganizationMemberInputFromJSON,
+    OrganizationMemberInputToJSON,
     PutEmailCredentialRequestFromJSON,
     PutEmailCredentialRequestToJSON,
     PutTicketCredentialRequestFromJSON,
     PutTicketCredentialRequestToJSON,
     RecordAttendanceRequestFromJSON,
     RecordAttendanceRequestToJSON,
+    RegisterScannerRequestFromJSON,
+    RegisterScannerRequestToJSON,
+    RegistrationFromJSON,
+    RegistrationToJSON,
+    RegistrationImportReportFromJSON,
+    RegistrationImportReportToJSON,
+    RegistrationImportRequestFromJSON,
+    RegistrationImportRequestToJSON,
+    RegistrationInputFromJSON,
+    RegistrationInputToJSON,
+    RevokedTicketFromJSON,
+    RevokedTicketToJSON,
+    ScannerFromJSON,
+    ScannerToJSON,
+    ScannerCredentialFromJSON,
+    ScannerCredentialToJSON,
     TicketCredentialFromJSON,
     TicketCredentialToJSON,
+    TicketIssuanceJobFromJSON,
+    TicketIssuanceJobToJSON,
+    TicketRevocationRequestFromJSON,
+    TicketRevocationRequestToJSON,
     UnencryptedEmailCredentialFromJSON,
     UnencryptedEmailCredentialToJSON,
     UnencryptedTicketCredentialFromJSON,
@@ -56,61 +142,1551 @@ import {
     UserLoginToJSON,
     UserUpdateFromJSON,
     UserUpdateToJSON,
+    WaitlistEntryFromJSON,
+    WaitlistEntryToJSON,
+    WaitlistStatusFromJSON,
+    WaitlistStatusToJSON,
 } from '../models/index';
 
+export interface EventsEventIdAttendanceBatchPostRequest {
+    eventId: string;
+    batchAttendanceRequest: BatchAttendanceRequest;
+}
+
 export interface EventsEventIdAttendancePostRequest {
     eventId: string;
     recordAttendanceRequest: RecordAttendanceRequest;
 }
 
-export interface EventsEventIdGetRequest {
-    eventId: string;
-}
+export interface EventsEventIdAttendanceStreamTicketPostRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdCredentialsPostRequest {
+    eventId: string;
+    credentialIssuanceRequest: CredentialIssuanceRequest;
+}
+
+export interface EventsEventIdDeleteRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdGetRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdIntegrationsGetRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdIntegrationsPostRequest {
+    eventId: string;
+    eventIntegrationInput: EventIntegrationInput;
+}
+
+export interface EventsEventIdManifestGetRequest {
+    eventId: string;
+    known_version?: string;
+}
+
+export interface EventsEventIdPutRequest {
+    eventId: string;
+    eventInput: EventInput;
+}
+
+export interface EventsEventIdRegisterPostRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdRegistrationDeleteRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdRegistrationsEmailDeleteRequest {
+    eventId: string;
+    email: string;
+}
+
+export interface EventsEventIdRegistrationsEmailPutRequest {
+    eventId: string;
+    email: string;
+    registrationInput: RegistrationInput;
+}
+
+export interface EventsEventIdRegistrationsImportPostRequest {
+    eventId: string;
+    registrationImportRequest: RegistrationImportRequest;
+}
+
+export interface EventsEventIdRequestTicketCredentialPostRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdRevocationsGetRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdRevocationsPostRequest {
+    eventId: string;
+    ticketRevocationRequest: TicketRevocationRequest;
+}
+
+export interface EventsEventIdScannersPostRequest {
+    eventId: string;
+    registerScannerRequest: RegisterScannerRequest;
+}
+
+export interface EventsEventIdScannersScannerIdRevokePostRequest {
+    eventId: string;
+    scannerId: string;
+}
+
+export interface EventsEventIdStatsGetRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdTicketIssuanceJobIdGetRequest {
+    eventId: string;
+    jobId: string;
+}
+
+export interface EventsEventIdTicketIssuancePostRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdWaitlistDeleteRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdWaitlistGetRequest {
+    eventId: string;
+}
+
+export interface EventsEventIdWaitlistPostRequest {
+    eventId: string;
+}
+
+export interface EventsPostRequest {
+    eventInput: EventInput;
+}
+
+export interface OrganizationsOrganizationIdCredentialTypesGetRequest {
+    organizationId: string;
+}
+
+export interface OrganizationsOrganizationIdCredentialTypesPostRequest {
+    organizationId: string;
+    credentialTypeInput: CredentialTypeInput;
+}
+
+export interface OrganizationsOrganizationIdEventsGetRequest {
+    organizationId: string;
+}
+
+export interface OrganizationsOrganizationIdMembersGetRequest {
+    organizationId: string;
+}
+
+export interface OrganizationsOrganizationIdMembersPutRequest {
+    organizationId: string;
+    organizationMemberInput: OrganizationMemberInput;
+}
+
+export interface OrganizationsOrganizationIdMembersUserIdDeleteRequest {
+    organizationId: string;
+    userId: string;
+}
+
+export interface OrganizationsPostRequest {
+    organizationInput: OrganizationInput;
+}
+
+export interface UserLoginPostRequest {
+    userLogin: UserLogin;
+}
+
+export interface UserMeEmailCredentialPutRequest {
+    putEmailCredentialRequest: PutEmailCredentialRequest;
+}
+
+export interface UserMeTicketCredentialPutRequest {
+    putTicketCredentialRequest: PutTicketCredentialRequest;
+}
+
+export interface UserRequestVerificationCodePostRequest {
+    userEmailVerificationRequest: UserEmailVerificationRequest;
+}
+
+export interface UserUpdatePutRequest {
+    userUpdate: UserUpdate;
+}
+
+/**
+ * 
+ */
+export class DefaultApi extends runtime.BaseAPI {
+
+    /**
+     * Upload attendance recorded while offline
+     */
+    async eventsEventIdAttendanceBatchPostRaw(requestParameters: EventsEventIdAttendanceBatchPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<BatchAttendanceResponse>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdAttendanceBatchPost().'
+            );
+        }
+
+        if (requestParameters['batchAttendanceRequest'] == null) {
+            throw new runtime.RequiredError(
+                'batchAttendanceRequest',
+                'Required parameter "batchAttendanceRequest" was null or undefined when calling eventsEventIdAttendanceBatchPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/attendance/batch`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: BatchAttendanceRequestToJSON(requestParameters['batchAttendanceRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => BatchAttendanceResponseFromJSON(jsonValue));
+    }
+
+    /**
+     * Upload attendance recorded while offline
+     */
+    async eventsEventIdAttendanceBatchPost(requestParameters: EventsEventIdAttendanceBatchPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<BatchAttendanceResponse> {
+        const response = await this.eventsEventIdAttendanceBatchPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Record attendance for an event
+     */
+    async eventsEventIdAttendancePostRaw(requestParameters: EventsEventIdAttendancePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Attendance>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdAttendancePost().'
+            );
+        }
+
+        if (requestParameters['recordAttendanceRequest'] == null) {
+            throw new runtime.RequiredError(
+                'recordAttendanceRequest',
+                'Required parameter "recordAttendanceRequest" was null or undefined when calling eventsEventIdAttendancePost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/attendance`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: RecordAttendanceRequestToJSON(requestParameters['recordAttendanceRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => AttendanceFromJSON(jsonValue));
+    }
+
+    /**
+     * Record attendance for an event
+     */
+    async eventsEventIdAttendancePost(requestParameters: EventsEventIdAttendancePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Attendance> {
+        const response = await this.eventsEventIdAttendancePostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get a single use ticket to open the live attendance stream of an event
+     */
+    async eventsEventIdAttendanceStreamTicketPostRaw(requestParameters: EventsEventIdAttendanceStreamTicketPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<AttendanceStreamTicket>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdAttendanceStreamTicketPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/attendance/stream-ticket`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => AttendanceStreamTicketFromJSON(jsonValue));
+    }
+
+    /**
+     * Get a single use ticket to open the live attendance stream of an event
+     */
+    async eventsEventIdAttendanceStreamTicketPost(requestParameters: EventsEventIdAttendanceStreamTicketPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<AttendanceStreamTicket> {
+        const response = await this.eventsEventIdAttendanceStreamTicketPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Issue credentials of a type to registrants of the event, such as speaker or volunteer credentials
+     */
+    async eventsEventIdCredentialsPostRaw(requestParameters: EventsEventIdCredentialsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<CredentialIssuanceReport>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdCredentialsPost().'
+            );
+        }
+
+        if (requestParameters['credentialIssuanceRequest'] == null) {
+            throw new runtime.RequiredError(
+                'credentialIssuanceRequest',
+                'Required parameter "credentialIssuanceRequest" was null or undefined when calling eventsEventIdCredentialsPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/credentials`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: CredentialIssuanceRequestToJSON(requestParameters['credentialIssuanceRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => CredentialIssuanceReportFromJSON(jsonValue));
+    }
+
+    /**
+     * Issue credentials of a type to registrants of the event, such as speaker or volunteer credentials
+     */
+    async eventsEventIdCredentialsPost(requestParameters: EventsEventIdCredentialsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<CredentialIssuanceReport> {
+        const response = await this.eventsEventIdCredentialsPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Delete an event with its registrations, credentials and attendance
+     */
+    async eventsEventIdDeleteRaw(requestParameters: EventsEventIdDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdDelete().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Delete an event with its registrations, credentials and attendance
+     */
+    async eventsEventIdDelete(requestParameters: EventsEventIdDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.eventsEventIdDeleteRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Get event details
+     */
+    async eventsEventIdGetRaw(requestParameters: EventsEventIdGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Event>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/events/{eventId}`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => EventFromJSON(jsonValue));
+    }
+
+    /**
+     * Get event details
+     */
+    async eventsEventIdGet(requestParameters: EventsEventIdGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Event> {
+        const response = await this.eventsEventIdGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List the ticketing platform events linked to an event
+     */
+    async eventsEventIdIntegrationsGetRaw(requestParameters: EventsEventIdIntegrationsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<EventIntegration>>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdIntegrationsGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/integrations`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(EventIntegrationFromJSON));
+    }
+
+    /**
+     * List the ticketing platform events linked to an event
+     */
+    async eventsEventIdIntegrationsGet(requestParameters: EventsEventIdIntegrationsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<EventIntegration>> {
+        const response = await this.eventsEventIdIntegrationsGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Link an event on a ticketing platform, so that its order webhooks register attendees
+     */
+    async eventsEventIdIntegrationsPostRaw(requestParameters: EventsEventIdIntegrationsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<EventIntegration>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdIntegrationsPost().'
+            );
+        }
+
+        if (requestParameters['eventIntegrationInput'] == null) {
+            throw new runtime.RequiredError(
+                'eventIntegrationInput',
+                'Required parameter "eventIntegrationInput" was null or undefined when calling eventsEventIdIntegrationsPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/integrations`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: EventIntegrationInputToJSON(requestParameters['eventIntegrationInput']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => EventIntegrationFromJSON(jsonValue));
+    }
+
+    /**
+     * Link an event on a ticketing platform, so that its order webhooks register attendees
+     */
+    async eventsEventIdIntegrationsPost(requestParameters: EventsEventIdIntegrationsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<EventIntegration> {
+        const response = await this.eventsEventIdIntegrationsPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Download the signed verification manifest for offline scanners
+     */
+    async eventsEventIdManifestGetRaw(requestParameters: EventsEventIdManifestGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<EventManifest>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdManifestGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        if (requestParameters['known_version'] != null) {
+            queryParameters['known_version'] = requestParameters['known_version'];
+        }
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/manifest`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => EventManifestFromJSON(jsonValue));
+    }
+
+    /**
+     * Download the signed verification manifest for offline scanners
+     */
+    async eventsEventIdManifestGet(requestParameters: EventsEventIdManifestGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<EventManifest> {
+        const response = await this.eventsEventIdManifestGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Update an event
+     */
+    async eventsEventIdPutRaw(requestParameters: EventsEventIdPutRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Event>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdPut().'
+            );
+        }
+
+        if (requestParameters['eventInput'] == null) {
+            throw new runtime.RequiredError(
+                'eventInput',
+                'Required parameter "eventInput" was null or undefined when calling eventsEventIdPut().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'PUT',
+            headers: headerParameters,
+            query: queryParameters,
+            body: EventInputToJSON(requestParameters['eventInput']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => EventFromJSON(jsonValue));
+    }
+
+    /**
+     * Update an event
+     */
+    async eventsEventIdPut(requestParameters: EventsEventIdPutRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Event> {
+        const response = await this.eventsEventIdPutRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Register the user for an open event, or for a domain event with an email of an allowed domain
+     */
+    async eventsEventIdRegisterPostRaw(requestParameters: EventsEventIdRegisterPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WaitlistStatus>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRegisterPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/register`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => WaitlistStatusFromJSON(jsonValue));
+    }
+
+    /**
+     * Register the user for an open event, or for a domain event with an email of an allowed domain
+     */
+    async eventsEventIdRegisterPost(requestParameters: EventsEventIdRegisterPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WaitlistStatus> {
+        const response = await this.eventsEventIdRegisterPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Cancel the registration of the user, the ticket of the user is deleted and revoked
+     */
+    async eventsEventIdRegistrationDeleteRaw(requestParameters: EventsEventIdRegistrationDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRegistrationDelete().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/registration`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Cancel the registration of the user, the ticket of the user is deleted and revoked
+     */
+    async eventsEventIdRegistrationDelete(requestParameters: EventsEventIdRegistrationDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.eventsEventIdRegistrationDeleteRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Cancel the registration of an attendee, the ticket of the attendee is deleted and revoked
+     */
+    async eventsEventIdRegistrationsEmailDeleteRaw(requestParameters: EventsEventIdRegistrationsEmailDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRegistrationsEmailDelete().'
+            );
+        }
+
+        if (requestParameters['email'] == null) {
+            throw new runtime.RequiredError(
+                'email',
+                'Required parameter "email" was null or undefined when calling eventsEventIdRegistrationsEmailDelete().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/registrations/{email}`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))).replace(`{${"email"}}`, encodeURIComponent(String(requestParameters['email']))),
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Cancel the registration of an attendee, the ticket of the attendee is deleted and revoked
+     */
+    async eventsEventIdRegistrationsEmailDelete(requestParameters: EventsEventIdRegistrationsEmailDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.eventsEventIdRegistrationsEmailDeleteRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Update the registration of an attendee, such as the ticket tier
+     */
+    async eventsEventIdRegistrationsEmailPutRaw(requestParameters: EventsEventIdRegistrationsEmailPutRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Registration>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRegistrationsEmailPut().'
+            );
+        }
+
+        if (requestParameters['email'] == null) {
+            throw new runtime.RequiredError(
+                'email',
+                'Required parameter "email" was null or undefined when calling eventsEventIdRegistrationsEmailPut().'
+            );
+        }
+
+        if (requestParameters['registrationInput'] == null) {
+            throw new runtime.RequiredError(
+                'registrationInput',
+                'Required parameter "registrationInput" was null or undefined when calling eventsEventIdRegistrationsEmailPut().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/registrations/{email}`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))).replace(`{${"email"}}`, encodeURIComponent(String(requestParameters['email']))),
+            method: 'PUT',
+            headers: headerParameters,
+            query: queryParameters,
+            body: RegistrationInputToJSON(requestParameters['registrationInput']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => RegistrationFromJSON(jsonValue));
+    }
+
+    /**
+     * Update the registration of an attendee, such as the ticket tier
+     */
+    async eventsEventIdRegistrationsEmailPut(requestParameters: EventsEventIdRegistrationsEmailPutRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Registration> {
+        const response = await this.eventsEventIdRegistrationsEmailPutRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Import registrations from a CSV of emails
+     */
+    async eventsEventIdRegistrationsImportPostRaw(requestParameters: EventsEventIdRegistrationsImportPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<RegistrationImportReport>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRegistrationsImportPost().'
+            );
+        }
+
+        if (requestParameters['registrationImportRequest'] == null) {
+            throw new runtime.RequiredError(
+                'registrationImportRequest',
+                'Required parameter "registrationImportRequest" was null or undefined when calling eventsEventIdRegistrationsImportPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/registrations/import`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: RegistrationImportRequestToJSON(requestParameters['registrationImportRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => RegistrationImportReportFromJSON(jsonValue));
+    }
+
+    /**
+     * Import registrations from a CSV of emails
+     */
+    async eventsEventIdRegistrationsImportPost(requestParameters: EventsEventIdRegistrationsImportPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<RegistrationImportReport> {
+        const response = await this.eventsEventIdRegistrationsImportPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Request a new ticket credential for an event
+     */
+    async eventsEventIdRequestTicketCredentialPostRaw(requestParameters: EventsEventIdRequestTicketCredentialPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<UnencryptedTicketCredential>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRequestTicketCredentialPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/request-ticket-credential`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => UnencryptedTicketCredentialFromJSON(jsonValue));
+    }
+
+    /**
+     * Request a new ticket credential for an event
+     */
+    async eventsEventIdRequestTicketCredentialPost(requestParameters: EventsEventIdRequestTicketCredentialPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<UnencryptedTicketCredential> {
+        const response = await this.eventsEventIdRequestTicketCredentialPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get the revocation list of an event, scanners reject the tickets on it
+     */
+    async eventsEventIdRevocationsGetRaw(requestParameters: EventsEventIdRevocationsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<RevokedTicket>>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRevocationsGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/revocations`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(RevokedTicketFromJSON));
+    }
+
+    /**
+     * Get the revocation list of an event, scanners reject the tickets on it
+     */
+    async eventsEventIdRevocationsGet(requestParameters: EventsEventIdRevocationsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<RevokedTicket>> {
+        const response = await this.eventsEventIdRevocationsGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Revoke the tickets issued to an email, for refunds and bans
+     */
+    async eventsEventIdRevocationsPostRaw(requestParameters: EventsEventIdRevocationsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<RevokedTicket>>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdRevocationsPost().'
+            );
+        }
+
+        if (requestParameters['ticketRevocationRequest'] == null) {
+            throw new runtime.RequiredError(
+                'ticketRevocationRequest',
+                'Required parameter "ticketRevocationRequest" was null or undefined when calling eventsEventIdRevocationsPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/revocations`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: TicketRevocationRequestToJSON(requestParameters['ticketRevocationRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(RevokedTicketFromJSON));
+    }
+
+    /**
+     * Revoke the tickets issued to an email, for refunds and bans
+     */
+    async eventsEventIdRevocationsPost(requestParameters: EventsEventIdRevocationsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<RevokedTicket>> {
+        const response = await this.eventsEventIdRevocationsPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Register a scanner device for an event
+     */
+    async eventsEventIdScannersPostRaw(requestParameters: EventsEventIdScannersPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<ScannerCredential>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdScannersPost().'
+            );
+        }
+
+        if (requestParameters['registerScannerRequest'] == null) {
+            throw new runtime.RequiredError(
+                'registerScannerRequest',
+                'Required parameter "registerScannerRequest" was null or undefined when calling eventsEventIdScannersPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/scanners`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: RegisterScannerRequestToJSON(requestParameters['registerScannerRequest']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ScannerCredentialFromJSON(jsonValue));
+    }
+
+    /**
+     * Register a scanner device for an event
+     */
+    async eventsEventIdScannersPost(requestParameters: EventsEventIdScannersPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<ScannerCredential> {
+        const response = await this.eventsEventIdScannersPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Revoke a scanner device
+     */
+    async eventsEventIdScannersScannerIdRevokePostRaw(requestParameters: EventsEventIdScannersScannerIdRevokePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Scanner>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdScannersScannerIdRevokePost().'
+            );
+        }
+
+        if (requestParameters['scannerId'] == null) {
+            throw new runtime.RequiredError(
+                'scannerId',
+                'Required parameter "scannerId" was null or undefined when calling eventsEventIdScannersScannerIdRevokePost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/scanners/{scannerId}/revoke`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))).replace(`{${"scannerId"}}`, encodeURIComponent(String(requestParameters['scannerId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => ScannerFromJSON(jsonValue));
+    }
+
+    /**
+     * Revoke a scanner device
+     */
+    async eventsEventIdScannersScannerIdRevokePost(requestParameters: EventsEventIdScannersScannerIdRevokePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Scanner> {
+        const response = await this.eventsEventIdScannersScannerIdRevokePostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get registration, issuance and check-in statistics for an event
+     */
+    async eventsEventIdStatsGetRaw(requestParameters: EventsEventIdStatsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<EventStats>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdStatsGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/stats`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => EventStatsFromJSON(jsonValue));
+    }
+
+    /**
+     * Get registration, issuance and check-in statistics for an event
+     */
+    async eventsEventIdStatsGet(requestParameters: EventsEventIdStatsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<EventStats> {
+        const response = await this.eventsEventIdStatsGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Get the progress of a ticket pre-issuance job
+     */
+    async eventsEventIdTicketIssuanceJobIdGetRaw(requestParameters: EventsEventIdTicketIssuanceJobIdGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<TicketIssuanceJob>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdTicketIssuanceJobIdGet().'
+            );
+        }
+
+        if (requestParameters['jobId'] == null) {
+            throw new runtime.RequiredError(
+                'jobId',
+                'Required parameter "jobId" was null or undefined when calling eventsEventIdTicketIssuanceJobIdGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/ticket-issuance/{jobId}`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))).replace(`{${"jobId"}}`, encodeURIComponent(String(requestParameters['jobId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => TicketIssuanceJobFromJSON(jsonValue));
+    }
+
+    /**
+     * Get the progress of a ticket pre-issuance job
+     */
+    async eventsEventIdTicketIssuanceJobIdGet(requestParameters: EventsEventIdTicketIssuanceJobIdGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<TicketIssuanceJob> {
+        const response = await this.eventsEventIdTicketIssuanceJobIdGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Start a job that pre-issues tickets to every registrant with an identity commitment
+     */
+    async eventsEventIdTicketIssuancePostRaw(requestParameters: EventsEventIdTicketIssuancePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<TicketIssuanceJob>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdTicketIssuancePost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/ticket-issuance`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => TicketIssuanceJobFromJSON(jsonValue));
+    }
+
+    /**
+     * Start a job that pre-issues tickets to every registrant with an identity commitment
+     */
+    async eventsEventIdTicketIssuancePost(requestParameters: EventsEventIdTicketIssuancePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<TicketIssuanceJob> {
+        const response = await this.eventsEventIdTicketIssuancePostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Leave the waitlist of an event
+     */
+    async eventsEventIdWaitlistDeleteRaw(requestParameters: EventsEventIdWaitlistDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdWaitlistDelete().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/waitlist`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'DELETE',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Leave the waitlist of an event
+     */
+    async eventsEventIdWaitlistDelete(requestParameters: EventsEventIdWaitlistDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.eventsEventIdWaitlistDeleteRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * List the waitlist of an event in order
+     */
+    async eventsEventIdWaitlistGetRaw(requestParameters: EventsEventIdWaitlistGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<WaitlistEntry>>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdWaitlistGet().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/waitlist`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(WaitlistEntryFromJSON));
+    }
+
+    /**
+     * List the waitlist of an event in order
+     */
+    async eventsEventIdWaitlistGet(requestParameters: EventsEventIdWaitlistGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<WaitlistEntry>> {
+        const response = await this.eventsEventIdWaitlistGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Join the waitlist of a full event, the user is registered right away if there is a free place
+     */
+    async eventsEventIdWaitlistPostRaw(requestParameters: EventsEventIdWaitlistPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<WaitlistStatus>> {
+        if (requestParameters['eventId'] == null) {
+            throw new runtime.RequiredError(
+                'eventId',
+                'Required parameter "eventId" was null or undefined when calling eventsEventIdWaitlistPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events/{eventId}/waitlist`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => WaitlistStatusFromJSON(jsonValue));
+    }
+
+    /**
+     * Join the waitlist of a full event, the user is registered right away if there is a free place
+     */
+    async eventsEventIdWaitlistPost(requestParameters: EventsEventIdWaitlistPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<WaitlistStatus> {
+        const response = await this.eventsEventIdWaitlistPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * List published events
+     */
+    async eventsGetRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<Event>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/events`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(EventFromJSON));
+    }
+
+    /**
+     * List published events
+     */
+    async eventsGet(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<Event>> {
+        const response = await this.eventsGetRaw(initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Create an event
+     */
+    async eventsPostRaw(requestParameters: EventsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Event>> {
+        if (requestParameters['eventInput'] == null) {
+            throw new runtime.RequiredError(
+                'eventInput',
+                'Required parameter "eventInput" was null or undefined when calling eventsPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/events`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: EventInputToJSON(requestParameters['eventInput']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => EventFromJSON(jsonValue));
+    }
+
+    /**
+     * Create an event
+     */
+    async eventsPost(requestParameters: EventsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Event> {
+        const response = await this.eventsPostRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Check the health of the API
+     */
+    async healthGetRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        const response = await this.request({
+            path: `/health`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.VoidApiResponse(response);
+    }
+
+    /**
+     * Check the health of the API
+     */
+    async healthGet(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.healthGetRaw(initOverrides);
+    }
+
+    /**
+     * List the organizations of the user
+     */
+    async organizationsGetRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<Organization>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
 
-export interface EventsEventIdRequestTicketCredentialPostRequest {
-    eventId: string;
-}
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/organizations`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
 
-export interface UserLoginPostRequest {
-    userLogin: UserLogin;
-}
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(OrganizationFromJSON));
+    }
 
-export interface UserMeEmailCredentialPutRequest {
-    putEmailCredentialRequest: PutEmailCredentialRequest;
-}
+    /**
+     * List the organizations of the user
+     */
+    async organizationsGet(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<Organization>> {
+        const response = await this.organizationsGetRaw(initOverrides);
+        return await response.value();
+    }
 
-export interface UserMeTicketCredentialPutRequest {
-    putTicketCredentialRequest: PutTicketCredentialRequest;
-}
+    /**
+     * List the credential types an organization can issue, the primitive types and the types it defined
+     */
+    async organizationsOrganizationIdCredentialTypesGetRaw(requestParameters: OrganizationsOrganizationIdCredentialTypesGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<CredentialType>>> {
+        if (requestParameters['organizationId'] == null) {
+            throw new runtime.RequiredError(
+                'organizationId',
+                'Required parameter "organizationId" was null or undefined when calling organizationsOrganizationIdCredentialTypesGet().'
+            );
+        }
 
-export interface UserRequestVerificationCodePostRequest {
-    userEmailVerificationRequest: UserEmailVerificationRequest;
-}
+        const queryParameters: any = {};
 
-export interface UserUpdatePutRequest {
-    userUpdate: UserUpdate;
-}
+        const headerParameters: runtime.HTTPHeaders = {};
 
-/**
- * 
- */
-export class DefaultApi extends runtime.BaseAPI {
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/organizations/{organizationId}/credential-types`.replace(`{${"organizationId"}}`, encodeURIComponent(String(requestParameters['organizationId']))),
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(CredentialTypeFromJSON));
+    }
 
     /**
-     * Record attendance for an event
+     * List the credential types an organization can issue, the primitive types and the types it defined
      */
-    async eventsEventIdAttendancePostRaw(requestParameters: EventsEventIdAttendancePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
-        if (requestParameters['eventId'] == null) {
+    async organizationsOrganizationIdCredentialTypesGet(requestParameters: OrganizationsOrganizationIdCredentialTypesGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<CredentialType>> {
+        const response = await this.organizationsOrganizationIdCredentialTypesGetRaw(requestParameters, initOverrides);
+        return await response.value();
+    }
+
+    /**
+     * Register a credential type of the organization, the type must already be registered with the type registry of the protocol
+     */
+    async organizationsOrganizationIdCredentialTypesPostRaw(requestParameters: OrganizationsOrganizationIdCredentialTypesPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<CredentialType>> {
+        if (requestParameters['organizationId'] == null) {
             throw new runtime.RequiredError(
-                'eventId',
-                'Required parameter "eventId" was null or undefined when calling eventsEventIdAttendancePost().'
+                'organizationId',
+                'Required parameter "organizationId" was null or undefined when calling organizationsOrganizationIdCredentialTypesPost().'
             );
         }
 
-        if (requestParameters['recordAttendanceRequest'] == null) {
+        if (requestParameters['credentialTypeInput'] == null) {
             throw new runtime.RequiredError(
-                'recordAttendanceRequest',
-                'Required parameter "recordAttendanceRequest" was null or undefined when calling eventsEventIdAttendancePost().'
+                'credentialTypeInput',
+                'Required parameter "credentialTypeInput" was null or undefined when calling organizationsOrganizationIdCredentialTypesPost().'
             );
         }
 
@@ -120,32 +1696,41 @@ export class DefaultApi extends runtime.BaseAPI {
 
         headerParameters['Content-Type'] = 'application/json';
 
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
         const response = await this.request({
-            path: `/events/{eventId}/attendance`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            path: `/organizations/{organizationId}/credential-types`.replace(`{${"organizationId"}}`, encodeURIComponent(String(requestParameters['organizationId']))),
             method: 'POST',
             headers: headerParameters,
             query: queryParameters,
-            body: RecordAttendanceRequestToJSON(requestParameters['recordAttendanceRequest']),
+            body: CredentialTypeInputToJSON(requestParameters['credentialTypeInput']),
         }, initOverrides);
 
-        return new runtime.VoidApiResponse(response);
+        return new runtime.JSONApiResponse(response, (jsonValue) => CredentialTypeFromJSON(jsonValue));
     }
 
     /**
-     * Record attendance for an event
+     * Register a credential type of the organization, the type must already be registered with the type registry of the protocol
      */
-    async eventsEventIdAttendancePost(requestParameters: EventsEventIdAttendancePostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
-        await this.eventsEventIdAttendancePostRaw(requestParameters, initOverrides);
+    async organizationsOrganizationIdCredentialTypesPost(requestParameters: OrganizationsOrganizationIdCredentialTypesPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<CredentialType> {
+        const response = await this.organizationsOrganizationIdCredentialTypesPostRaw(requestParameters, initOverrides);
+        return await response.value();
     }
 
     /**
-     * Get event details
+     * List every event of an organization, including drafts and scheduled events
      */
-    async eventsEventIdGetRaw(requestParameters: EventsEventIdGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Event>> {
-        if (requestParameters['eventId'] == null) {
+    async organizationsOrganizationIdEventsGetRaw(requestParameters: OrganizationsOrganizationIdEventsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<Event>>> {
+        if (requestParameters['organizationId'] == null) {
             throw new runtime.RequiredError(
-                'eventId',
-                'Required parameter "eventId" was null or undefined when calling eventsEventIdGet().'
+                'organizationId',
+                'Required parameter "organizationId" was null or undefined when calling organizationsOrganizationIdEventsGet().'
             );
         }
 
@@ -153,32 +1738,40 @@ export class DefaultApi extends runtime.BaseAPI {
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
         const response = await this.request({
-            path: `/events/{eventId}`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
+            path: `/organizations/{organizationId}/events`.replace(`{${"organizationId"}}`, encodeURIComponent(String(requestParameters['organizationId']))),
             method: 'GET',
             headers: headerParameters,
             query: queryParameters,
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => EventFromJSON(jsonValue));
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(EventFromJSON));
     }
 
     /**
-     * Get event details
+     * List every event of an organization, including drafts and scheduled events
      */
-    async eventsEventIdGet(requestParameters: EventsEventIdGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Event> {
-        const response = await this.eventsEventIdGetRaw(requestParameters, initOverrides);
+    async organizationsOrganizationIdEventsGet(requestParameters: OrganizationsOrganizationIdEventsGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<Event>> {
+        const response = await this.organizationsOrganizationIdEventsGetRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
     /**
-     * Request a new ticket credential for an event
+     * List the members of an organization
      */
-    async eventsEventIdRequestTicketCredentialPostRaw(requestParameters: EventsEventIdRequestTicketCredentialPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<UnencryptedTicketCredential>> {
-        if (requestParameters['eventId'] == null) {
+    async organizationsOrganizationIdMembersGetRaw(requestParameters: OrganizationsOrganizationIdMembersGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<OrganizationMember>>> {
+        if (requestParameters['organizationId'] == null) {
             throw new runtime.RequiredError(
-                'eventId',
-                'Required parameter "eventId" was null or undefined when calling eventsEventIdRequestTicketCredentialPost().'
+                'organizationId',
+                'Required parameter "organizationId" was null or undefined when calling organizationsOrganizationIdMembersGet().'
             );
         }
 
@@ -195,60 +1788,107 @@ export class DefaultApi extends runtime.BaseAPI {
             }
         }
         const response = await this.request({
-            path: `/events/{eventId}/request-ticket-credential`.replace(`{${"eventId"}}`, encodeURIComponent(String(requestParameters['eventId']))),
-            method: 'POST',
+            path: `/organizations/{organizationId}/members`.replace(`{${"organizationId"}}`, encodeURIComponent(String(requestParameters['organizationId']))),
+            method: 'GET',
             headers: headerParameters,
             query: queryParameters,
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => UnencryptedTicketCredentialFromJSON(jsonValue));
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(OrganizationMemberFromJSON));
     }
 
     /**
-     * Request a new ticket credential for an event
+     * List the members of an organization
      */
-    async eventsEventIdRequestTicketCredentialPost(requestParameters: EventsEventIdRequestTicketCredentialPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<UnencryptedTicketCredential> {
-        const response = await this.eventsEventIdRequestTicketCredentialPostRaw(requestParameters, initOverrides);
+    async organizationsOrganizationIdMembersGet(requestParameters: OrganizationsOrganizationIdMembersGetRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<OrganizationMember>> {
+        const response = await this.organizationsOrganizationIdMembersGetRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
     /**
-     * Get list of events
+     * Add a member to an organization or change the role of a member
      */
-    async eventsGetRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<Event>>> {
+    async organizationsOrganizationIdMembersPutRaw(requestParameters: OrganizationsOrganizationIdMembersPutRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<OrganizationMember>> {
+        if (requestParameters['organizationId'] == null) {
+            throw new runtime.RequiredError(
+                'organizationId',
+                'Required parameter "organizationId" was null or undefined when calling organizationsOrganizationIdMembersPut().'
+            );
+        }
+
+        if (requestParameters['organizationMemberInput'] == null) {
+            throw new runtime.RequiredError(
+                'organizationMemberInput',
+                'Required parameter "organizationMemberInput" was null or undefined when calling organizationsOrganizationIdMembersPut().'
+            );
+        }
+
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
         const response = await this.request({
-            path: `/events`,
-            method: 'GET',
+            path: `/organizations/{organizationId}/members`.replace(`{${"organizationId"}}`, encodeURIComponent(String(requestParameters['organizationId']))),
+            method: 'PUT',
             headers: headerParameters,
             query: queryParameters,
+            body: OrganizationMemberInputToJSON(requestParameters['organizationMemberInput']),
         }, initOverrides);
 
-        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(EventFromJSON));
+        return new runtime.JSONApiResponse(response, (jsonValue) => OrganizationMemberFromJSON(jsonValue));
     }
 
     /**
-     * Get list of events
+     * Add a member to an organization or change the role of a member
      */
-    async eventsGet(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<Event>> {
-        const response = await this.eventsGetRaw(initOverrides);
+    async organizationsOrganizationIdMembersPut(requestParameters: OrganizationsOrganizationIdMembersPutRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<OrganizationMember> {
+        const response = await this.organizationsOrganizationIdMembersPutRaw(requestParameters, initOverrides);
         return await response.value();
     }
 
     /**
-     * Check the health of the API
+     * Remove a member from an organization
      */
-    async healthGetRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+    async organizationsOrganizationIdMembersUserIdDeleteRaw(requestParameters: OrganizationsOrganizationIdMembersUserIdDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<void>> {
+        if (requestParameters['organizationId'] == null) {
+            throw new runtime.RequiredError(
+                'organizationId',
+                'Required parameter "organizationId" was null or undefined when calling organizationsOrganizationIdMembersUserIdDelete().'
+            );
+        }
+
+        if (requestParameters['userId'] == null) {
+            throw new runtime.RequiredError(
+                'userId',
+                'Required parameter "userId" was null or undefined when calling organizationsOrganizationIdMembersUserIdDelete().'
+            );
+        }
+
         const queryParameters: any = {};
 
         const headerParameters: runtime.HTTPHeaders = {};
 
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
         const response = await this.request({
-            path: `/health`,
-            method: 'GET',
+            path: `/organizations/{organizationId}/members/{userId}`.replace(`{${"organizationId"}}`, encodeURIComponent(String(requestParameters['organizationId']))).replace(`{${"userId"}}`, encodeURIComponent(String(requestParameters['userId']))),
+            method: 'DELETE',
             headers: headerParameters,
             query: queryParameters,
         }, initOverrides);
@@ -257,10 +1897,54 @@ export class DefaultApi extends runtime.BaseAPI {
     }
 
     /**
-     * Check the health of the API
+     * Remove a member from an organization
      */
-    async healthGet(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
-        await this.healthGetRaw(initOverrides);
+    async organizationsOrganizationIdMembersUserIdDelete(requestParameters: OrganizationsOrganizationIdMembersUserIdDeleteRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<void> {
+        await this.organizationsOrganizationIdMembersUserIdDeleteRaw(requestParameters, initOverrides);
+    }
+
+    /**
+     * Create an organization, the user becomes its owner
+     */
+    async organizationsPostRaw(requestParameters: OrganizationsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Organization>> {
+        if (requestParameters['organizationInput'] == null) {
+            throw new runtime.RequiredError(
+                'organizationInput',
+                'Required parameter "organizationInput" was null or undefined when calling organizationsPost().'
+            );
+        }
+
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        headerParameters['Content-Type'] = 'application/json';
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/organizations`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+            body: OrganizationInputToJSON(requestParameters['organizationInput']),
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => OrganizationFromJSON(jsonValue));
+    }
+
+    /**
+     * Create an organization, the user becomes its owner
+     */
+    async organizationsPost(requestParameters: OrganizationsPostRequest, initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Organization> {
+        const response = await this.organizationsPostRaw(requestParameters, initOverrides);
+        return await response.value();
     }
 
     /**
@@ -299,6 +1983,40 @@ export class DefaultApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     * Get the credentials issued to the user by event organizers
+     */
+    async userMeCredentialsGetRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<Array<EventCredential>>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/user/me/credentials`,
+            method: 'GET',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => jsonValue.map(EventCredentialFromJSON));
+    }
+
+    /**
+     * Get the credentials issued to the user by event organizers
+     */
+    async userMeCredentialsGet(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<Array<EventCredential>> {
+        const response = await this.userMeCredentialsGetRaw(initOverrides);
+        return await response.value();
+    }
+
     /**
      * Get user email credential
      */
@@ -444,6 +2162,40 @@ export class DefaultApi extends runtime.BaseAPI {
         return await response.value();
     }
 
+    /**
+     * Generate a new email domain credential, with a hashed claim of the email domain so that membership of the domain can be proven without the address
+     */
+    async userMeRequestEmailDomainCredentialPostRaw(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<runtime.ApiResponse<UnencryptedEmailCredential>> {
+        const queryParameters: any = {};
+
+        const headerParameters: runtime.HTTPHeaders = {};
+
+        if (this.configuration && this.configuration.accessToken) {
+            const token = this.configuration.accessToken;
+            const tokenString = await token("bearerAuth", []);
+
+            if (tokenString) {
+                headerParameters["Authorization"] = `Bearer ${tokenString}`;
+            }
+        }
+        const response = await this.request({
+            path: `/user/me/request-email-domain-credential`,
+            method: 'POST',
+            headers: headerParameters,
+            query: queryParameters,
+        }, initOverrides);
+
+        return new runtime.JSONApiResponse(response, (jsonValue) => UnencryptedEmailCredentialFromJSON(jsonValue));
+    }
+
+    /**
+     * Generate a new email domain credential, with a hashed claim of the email domain so that membership of the domain can be proven without the address
+     */
+    async userMeRequestEmailDomainCredentialPost(initOverrides?: RequestInit | runtime.InitOverrideFunction): Promise<UnencryptedEmailCredential> {
+        const response = await this.userMeRequestEmailDomainCredentialPostRaw(initOverrides);
+        return await response.value();
+    }
+
     /**
      * Store user ticket credential with encrypted data
      */
@@ -0,0 +1,92 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface Attendance
+ */
+export interface Attendance {
+    /**
+     * 
+     * @type {string}
+     * @memberof Attendance
+     */
+    eventId?: string;
+    /**
+     * Number of times the ticket has been scanned
+     * @type {number}
+     * @memberof Attendance
+     */
+    scanCount?: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Attendance
+     */
+    checkedInAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Attendance
+     */
+    lastScannedAt?: Date;
+    /**
+     * Scanner that recorded the first check-in
+     * @type {string}
+     * @memberof Attendance
+     */
+    scannerId?: string;
+}
+
+/**
+ * Check if a given object implements the Attendance interface.
+ */
+export function instanceOfAttendance(value: object): value is Attendance {
+    return true;
+}
+
+export function AttendanceFromJSON(json: any): Attendance {
+    return AttendanceFromJSONTyped(json, false);
+}
+
+export function AttendanceFromJSONTyped(json: any, ignoreDiscriminator: boolean): Attendance {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'scanCount': json['scan_count'] == null ? undefined : json['scan_count'],
+        'checkedInAt': json['checked_in_at'] == null ? undefined : (new Date(json['checked_in_at'])),
+        'lastScannedAt': json['last_scanned_at'] == null ? undefined : (new Date(json['last_scanned_at'])),
+        'scannerId': json['scanner_id'] == null ? undefined : json['scanner_id'],
+    };
+}
+
+export function AttendanceToJSON(value?: Attendance | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'event_id': value['eventId'],
+        'scan_count': value['scanCount'],
+        'checked_in_at': value['checkedInAt'] == null ? undefined : ((value['checkedInAt']).toISOString()),
+        'last_scanned_at': value['lastScannedAt'] == null ? undefined : ((value['lastScannedAt']).toISOString()),
+        'scanner_id': value['scannerId'],
+    };
+}
+
@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface AttendanceStreamTicket
+ */
+export interface AttendanceStreamTicket {
+    /**
+     * 
+     * @type {string}
+     * @memberof AttendanceStreamTicket
+     */
+    ticket?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof AttendanceStreamTicket
+     */
+    expireAt?: Date;
+}
+
+/**
+ * Check if a given object implements the AttendanceStreamTicket interface.
+ */
+export function instanceOfAttendanceStreamTicket(value: object): value is AttendanceStreamTicket {
+    return true;
+}
+
+export function AttendanceStreamTicketFromJSON(json: any): AttendanceStreamTicket {
+    return AttendanceStreamTicketFromJSONTyped(json, false);
+}
+
+export function AttendanceStreamTicketFromJSONTyped(json: any, ignoreDiscriminator: boolean): AttendanceStreamTicket {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'ticket': json['ticket'] == null ? undefined : json['ticket'],
+        'expireAt': json['expire_at'] == null ? undefined : (new Date(json['expire_at'])),
+    };
+}
+
+export function AttendanceStreamTicketToJSON(value?: AttendanceStreamTicket | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'ticket': value['ticket'],
+        'expire_at': value['expireAt'] == null ? undefined : ((value['expireAt']).toISOString()),
+    };
+}
+
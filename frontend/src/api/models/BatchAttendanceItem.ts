@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface BatchAttendanceItem
+ */
+export interface BatchAttendanceItem {
+    /**
+     * JSON encoded BabyZK proof
+     * @type {string}
+     * @memberof BatchAttendanceItem
+     */
+    proof?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof BatchAttendanceItem
+     */
+    publicSignals?: Array<string>;
+    /**
+     * Time the ticket was scanned on the device
+     * @type {Date}
+     * @memberof BatchAttendanceItem
+     */
+    scannedAt?: Date;
+    /**
+     * ID the device gave the scan, unique for the scanner. Uploading a scan with a nonce that was already recorded returns its original result
+     * @type {string}
+     * @memberof BatchAttendanceItem
+     */
+    nonce?: string;
+}
+
+/**
+ * Check if a given object implements the BatchAttendanceItem interface.
+ */
+export function instanceOfBatchAttendanceItem(value: object): value is BatchAttendanceItem {
+    return true;
+}
+
+export function BatchAttendanceItemFromJSON(json: any): BatchAttendanceItem {
+    return BatchAttendanceItemFromJSONTyped(json, false);
+}
+
+export function BatchAttendanceItemFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchAttendanceItem {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'proof': json['proof'] == null ? undefined : json['proof'],
+        'publicSignals': json['public_signals'] == null ? undefined : json['public_signals'],
+        'scannedAt': json['scanned_at'] == null ? undefined : (new Date(json['scanned_at'])),
+        'nonce': json['nonce'] == null ? undefined : json['nonce'],
+    };
+}
+
+export function BatchAttendanceItemToJSON(value?: BatchAttendanceItem | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'proof': value['proof'],
+        'public_signals': value['publicSignals'],
+        'scanned_at': value['scannedAt'] == null ? undefined : ((value['scannedAt']).toISOString()),
+        'nonce': value['nonce'],
+    };
+}
+
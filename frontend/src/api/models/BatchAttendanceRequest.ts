@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchAttendanceItem } from './BatchAttendanceItem';
+import {
+    BatchAttendanceItemFromJSON,
+    BatchAttendanceItemFromJSONTyped,
+    BatchAttendanceItemToJSON,
+} from './BatchAttendanceItem';
+
+/**
+ * 
+ * @export
+ * @interface BatchAttendanceRequest
+ */
+export interface BatchAttendanceRequest {
+    /**
+     * 
+     * @type {Array<BatchAttendanceItem>}
+     * @memberof BatchAttendanceRequest
+     */
+    items?: Array<BatchAttendanceItem>;
+    /**
+     * Lowest ticket tier let in, applied to every item
+     * @type {string}
+     * @memberof BatchAttendanceRequest
+     */
+    minTier?: string;
+}
+
+/**
+ * Check if a given object implements the BatchAttendanceRequest interface.
+ */
+export function instanceOfBatchAttendanceRequest(value: object): value is BatchAttendanceRequest {
+    return true;
+}
+
+export function BatchAttendanceRequestFromJSON(json: any): BatchAttendanceRequest {
+    return BatchAttendanceRequestFromJSONTyped(json, false);
+}
+
+export function BatchAttendanceRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchAttendanceRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'items': json['items'] == null ? undefined : ((json['items'] as Array<any>).map(BatchAttendanceItemFromJSON)),
+        'minTier': json['min_tier'] == null ? undefined : json['min_tier'],
+    };
+}
+
+export function BatchAttendanceRequestToJSON(value?: BatchAttendanceRequest | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'items': value['items'] == null ? undefined : ((value['items'] as Array<any>).map(BatchAttendanceItemToJSON)),
+        'min_tier': value['minTier'],
+    };
+}
+
@@ -0,0 +1,67 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { BatchAttendanceResult } from './BatchAttendanceResult';
+import {
+    BatchAttendanceResultFromJSON,
+    BatchAttendanceResultFromJSONTyped,
+    BatchAttendanceResultToJSON,
+} from './BatchAttendanceResult';
+
+/**
+ * 
+ * @export
+ * @interface BatchAttendanceResponse
+ */
+export interface BatchAttendanceResponse {
+    /**
+     * 
+     * @type {Array<BatchAttendanceResult>}
+     * @memberof BatchAttendanceResponse
+     */
+    results?: Array<BatchAttendanceResult>;
+}
+
+/**
+ * Check if a given object implements the BatchAttendanceResponse interface.
+ */
+export function instanceOfBatchAttendanceResponse(value: object): value is BatchAttendanceResponse {
+    return true;
+}
+
+export function BatchAttendanceResponseFromJSON(json: any): BatchAttendanceResponse {
+    return BatchAttendanceResponseFromJSONTyped(json, false);
+}
+
+export function BatchAttendanceResponseFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchAttendanceResponse {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'results': json['results'] == null ? undefined : ((json['results'] as Array<any>).map(BatchAttendanceResultFromJSON)),
+    };
+}
+
+export function BatchAttendanceResponseToJSON(value?: BatchAttendanceResponse | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'results': value['results'] == null ? undefined : ((value['results'] as Array<any>).map(BatchAttendanceResultToJSON)),
+    };
+}
+
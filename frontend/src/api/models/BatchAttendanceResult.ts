@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface BatchAttendanceResult
+ */
+export interface BatchAttendanceResult {
+    /**
+     * Position of the item in the request
+     * @type {number}
+     * @memberof BatchAttendanceResult
+     */
+    index?: number;
+    /**
+     * One of accepted, duplicate or invalid
+     * @type {string}
+     * @memberof BatchAttendanceResult
+     */
+    status?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof BatchAttendanceResult
+     */
+    nullifier?: string;
+    /**
+     * Why the item is invalid
+     * @type {string}
+     * @memberof BatchAttendanceResult
+     */
+    reason?: string;
+}
+
+/**
+ * Check if a given object implements the BatchAttendanceResult interface.
+ */
+export function instanceOfBatchAttendanceResult(value: object): value is BatchAttendanceResult {
+    return true;
+}
+
+export function BatchAttendanceResultFromJSON(json: any): BatchAttendanceResult {
+    return BatchAttendanceResultFromJSONTyped(json, false);
+}
+
+export function BatchAttendanceResultFromJSONTyped(json: any, ignoreDiscriminator: boolean): BatchAttendanceResult {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'index': json['index'] == null ? undefined : json['index'],
+        'status': json['status'] == null ? undefined : json['status'],
+        'nullifier': json['nullifier'] == null ? undefined : json['nullifier'],
+        'reason': json['reason'] == null ? undefined : json['reason'],
+    };
+}
+
+export function BatchAttendanceResultToJSON(value?: BatchAttendanceResult | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'index': value['index'],
+        'status': value['status'],
+        'nullifier': value['nullifier'],
+        'reason': value['reason'],
+    };
+}
+
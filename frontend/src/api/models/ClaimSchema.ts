@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface ClaimSchema
+ */
+export interface ClaimSchema {
+    /**
+     * 
+     * @type {string}
+     * @memberof ClaimSchema
+     */
+    name?: string;
+    /**
+     * scalar, property or boolean
+     * @type {string}
+     * @memberof ClaimSchema
+     */
+    type?: string;
+    /**
+     * Width in bits of scalar and property claims
+     * @type {number}
+     * @memberof ClaimSchema
+     */
+    width?: number;
+    /**
+     * Number of equality checks a proof can make on a property claim
+     * @type {number}
+     * @memberof ClaimSchema
+     */
+    equalChecks?: number;
+}
+
+/**
+ * Check if a given object implements the ClaimSchema interface.
+ */
+export function instanceOfClaimSchema(value: object): value is ClaimSchema {
+    return true;
+}
+
+export function ClaimSchemaFromJSON(json: any): ClaimSchema {
+    return ClaimSchemaFromJSONTyped(json, false);
+}
+
+export function ClaimSchemaFromJSONTyped(json: any, ignoreDiscriminator: boolean): ClaimSchema {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'type': json['type'] == null ? undefined : json['type'],
+        'width': json['width'] == null ? undefined : json['width'],
+        'equalChecks': json['equal_checks'] == null ? undefined : json['equal_checks'],
+    };
+}
+
+export function ClaimSchemaToJSON(value?: ClaimSchema | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'name': value['name'],
+        'type': value['type'],
+        'width': value['width'],
+        'equal_checks': value['equalChecks'],
+    };
+}
+
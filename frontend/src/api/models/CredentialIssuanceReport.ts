@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { CredentialIssuanceResult } from './CredentialIssuanceResult';
+import {
+    CredentialIssuanceResultFromJSON,
+    CredentialIssuanceResultFromJSONTyped,
+    CredentialIssuanceResultToJSON,
+} from './CredentialIssuanceResult';
+
+/**
+ * 
+ * @export
+ * @interface CredentialIssuanceReport
+ */
+export interface CredentialIssuanceReport {
+    /**
+     * 
+     * @type {number}
+     * @memberof CredentialIssuanceReport
+     */
+    issued?: number;
+    /**
+     * Recipients without an identity commitment, their credential is issued once they set it
+     * @type {number}
+     * @memberof CredentialIssuanceReport
+     */
+    queued?: number;
+    /**
+     * 
+     * @type {Array<CredentialIssuanceResult>}
+     * @memberof CredentialIssuanceReport
+     */
+    results?: Array<CredentialIssuanceResult>;
+}
+
+/**
+ * Check if a given object implements the CredentialIssuanceReport interface.
+ */
+export function instanceOfCredentialIssuanceReport(value: object): value is CredentialIssuanceReport {
+    return true;
+}
+
+export function CredentialIssuanceReportFromJSON(json: any): CredentialIssuanceReport {
+    return CredentialIssuanceReportFromJSONTyped(json, false);
+}
+
+export function CredentialIssuanceReportFromJSONTyped(json: any, ignoreDiscriminator: boolean): CredentialIssuanceReport {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'issued': json['issued'] == null ? undefined : json['issued'],
+        'queued': json['queued'] == null ? undefined : json['queued'],
+        'results': json['results'] == null ? undefined : ((json['results'] as Array<any>).map(CredentialIssuanceResultFromJSON)),
+    };
+}
+
+export function CredentialIssuanceReportToJSON(value?: CredentialIssuanceReport | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'issued': value['issued'],
+        'queued': value['queued'],
+        'results': value['results'] == null ? undefined : ((value['results'] as Array<any>).map(CredentialIssuanceResultToJSON)),
+    };
+}
+
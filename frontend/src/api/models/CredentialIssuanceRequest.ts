@@ -0,0 +1,75 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { CredentialRecipient } from './CredentialRecipient';
+import {
+    CredentialRecipientFromJSON,
+    CredentialRecipientFromJSONTyped,
+    CredentialRecipientToJSON,
+} from './CredentialRecipient';
+
+/**
+ * 
+ * @export
+ * @interface CredentialIssuanceRequest
+ */
+export interface CredentialIssuanceRequest {
+    /**
+     * Type of the credentials, a primitive type or a type of the organization of the event
+     * @type {string}
+     * @memberof CredentialIssuanceRequest
+     */
+    typeId?: string;
+    /**
+     * 
+     * @type {Array<CredentialRecipient>}
+     * @memberof CredentialIssuanceRequest
+     */
+    recipients?: Array<CredentialRecipient>;
+}
+
+/**
+ * Check if a given object implements the CredentialIssuanceRequest interface.
+ */
+export function instanceOfCredentialIssuanceRequest(value: object): value is CredentialIssuanceRequest {
+    return true;
+}
+
+export function CredentialIssuanceRequestFromJSON(json: any): CredentialIssuanceRequest {
+    return CredentialIssuanceRequestFromJSONTyped(json, false);
+}
+
+export function CredentialIssuanceRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): CredentialIssuanceRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'typeId': json['type_id'] == null ? undefined : json['type_id'],
+        'recipients': json['recipients'] == null ? undefined : ((json['recipients'] as Array<any>).map(CredentialRecipientFromJSON)),
+    };
+}
+
+export function CredentialIssuanceRequestToJSON(value?: CredentialIssuanceRequest | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'type_id': value['typeId'],
+        'recipients': value['recipients'] == null ? undefined : ((value['recipients'] as Array<any>).map(CredentialRecipientToJSON)),
+    };
+}
+
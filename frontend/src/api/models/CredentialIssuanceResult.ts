@@ -0,0 +1,76 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface CredentialIssuanceResult
+ */
+export interface CredentialIssuanceResult {
+    /**
+     * 
+     * @type {string}
+     * @memberof CredentialIssuanceResult
+     */
+    email?: string;
+    /**
+     * issued, queued, duplicate, not_registered or invalid
+     * @type {string}
+     * @memberof CredentialIssuanceResult
+     */
+    status?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof CredentialIssuanceResult
+     */
+    reason?: string;
+}
+
+/**
+ * Check if a given object implements the CredentialIssuanceResult interface.
+ */
+export function instanceOfCredentialIssuanceResult(value: object): value is CredentialIssuanceResult {
+    return true;
+}
+
+export function CredentialIssuanceResultFromJSON(json: any): CredentialIssuanceResult {
+    return CredentialIssuanceResultFromJSONTyped(json, false);
+}
+
+export function CredentialIssuanceResultFromJSONTyped(json: any, ignoreDiscriminator: boolean): CredentialIssuanceResult {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'] == null ? undefined : json['email'],
+        'status': json['status'] == null ? undefined : json['status'],
+        'reason': json['reason'] == null ? undefined : json['reason'],
+    };
+}
+
+export function CredentialIssuanceResultToJSON(value?: CredentialIssuanceResult | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+        'status': value['status'],
+        'reason': value['reason'],
+    };
+}
+
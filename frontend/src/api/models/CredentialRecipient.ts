@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface CredentialRecipient
+ */
+export interface CredentialRecipient {
+    /**
+     * 
+     * @type {string}
+     * @memberof CredentialRecipient
+     */
+    email?: string;
+    /**
+     * Value of each claim of the credential type, in the order of the claims
+     * @type {Array<string>}
+     * @memberof CredentialRecipient
+     */
+    claims?: Array<string>;
+}
+
+/**
+ * Check if a given object implements the CredentialRecipient interface.
+ */
+export function instanceOfCredentialRecipient(value: object): value is CredentialRecipient {
+    return true;
+}
+
+export function CredentialRecipientFromJSON(json: any): CredentialRecipient {
+    return CredentialRecipientFromJSONTyped(json, false);
+}
+
+export function CredentialRecipientFromJSONTyped(json: any, ignoreDiscriminator: boolean): CredentialRecipient {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'] == null ? undefined : json['email'],
+        'claims': json['claims'] == null ? undefined : json['claims'],
+    };
+}
+
+export function CredentialRecipientToJSON(value?: CredentialRecipient | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+        'claims': value['claims'],
+    };
+}
+
@@ -0,0 +1,107 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ClaimSchema } from './ClaimSchema';
+import {
+    ClaimSchemaFromJSON,
+    ClaimSchemaFromJSONTyped,
+    ClaimSchemaToJSON,
+} from './ClaimSchema';
+
+/**
+ * 
+ * @export
+ * @interface CredentialType
+ */
+export interface CredentialType {
+    /**
+     * 
+     * @type {string}
+     * @memberof CredentialType
+     */
+    typeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof CredentialType
+     */
+    name?: string;
+    /**
+     * Organization that defined the type, empty for the primitive types
+     * @type {string}
+     * @memberof CredentialType
+     */
+    organizationId?: string;
+    /**
+     * 
+     * @type {Array<ClaimSchema>}
+     * @memberof CredentialType
+     */
+    claims?: Array<ClaimSchema>;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof CredentialType
+     */
+    revocable?: boolean;
+    /**
+     * Validity of credentials of this type, unless the issuance sets its own
+     * @type {number}
+     * @memberof CredentialType
+     */
+    defaultTtlSeconds?: number;
+}
+
+/**
+ * Check if a given object implements the CredentialType interface.
+ */
+export function instanceOfCredentialType(value: object): value is CredentialType {
+    return true;
+}
+
+export function CredentialTypeFromJSON(json: any): CredentialType {
+    return CredentialTypeFromJSONTyped(json, false);
+}
+
+export function CredentialTypeFromJSONTyped(json: any, ignoreDiscriminator: boolean): CredentialType {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'typeId': json['type_id'] == null ? undefined : json['type_id'],
+        'name': json['name'] == null ? undefined : json['name'],
+        'organizationId': json['organization_id'] == null ? undefined : json['organization_id'],
+        'claims': json['claims'] == null ? undefined : ((json['claims'] as Array<any>).map(ClaimSchemaFromJSON)),
+        'revocable': json['revocable'] == null ? undefined : json['revocable'],
+        'defaultTtlSeconds': json['default_ttl_seconds'] == null ? undefined : json['default_ttl_seconds'],
+    };
+}
+
+export function CredentialTypeToJSON(value?: CredentialType | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'type_id': value['typeId'],
+        'name': value['name'],
+        'organization_id': value['organizationId'],
+        'claims': value['claims'] == null ? undefined : ((value['claims'] as Array<any>).map(ClaimSchemaToJSON)),
+        'revocable': value['revocable'],
+        'default_ttl_seconds': value['defaultTtlSeconds'],
+    };
+}
+
@@ -0,0 +1,99 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { ClaimSchema } from './ClaimSchema';
+import {
+    ClaimSchemaFromJSON,
+    ClaimSchemaFromJSONTyped,
+    ClaimSchemaToJSON,
+} from './ClaimSchema';
+
+/**
+ * 
+ * @export
+ * @interface CredentialTypeInput
+ */
+export interface CredentialTypeInput {
+    /**
+     * Decimal type ID in the type registry of the protocol
+     * @type {string}
+     * @memberof CredentialTypeInput
+     */
+    typeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof CredentialTypeInput
+     */
+    name?: string;
+    /**
+     * 
+     * @type {Array<ClaimSchema>}
+     * @memberof CredentialTypeInput
+     */
+    claims?: Array<ClaimSchema>;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof CredentialTypeInput
+     */
+    revocable?: boolean;
+    /**
+     * 
+     * @type {number}
+     * @memberof CredentialTypeInput
+     */
+    defaultTtlSeconds?: number;
+}
+
+/**
+ * Check if a given object implements the CredentialTypeInput interface.
+ */
+export function instanceOfCredentialTypeInput(value: object): value is CredentialTypeInput {
+    return true;
+}
+
+export function CredentialTypeInputFromJSON(json: any): CredentialTypeInput {
+    return CredentialTypeInputFromJSONTyped(json, false);
+}
+
+export function CredentialTypeInputFromJSONTyped(json: any, ignoreDiscriminator: boolean): CredentialTypeInput {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'typeId': json['type_id'] == null ? undefined : json['type_id'],
+        'name': json['name'] == null ? undefined : json['name'],
+        'claims': json['claims'] == null ? undefined : ((json['claims'] as Array<any>).map(ClaimSchemaFromJSON)),
+        'revocable': json['revocable'] == null ? undefined : json['revocable'],
+        'defaultTtlSeconds': json['default_ttl_seconds'] == null ? undefined : json['default_ttl_seconds'],
+    };
+}
+
+export function CredentialTypeInputToJSON(value?: CredentialTypeInput | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'type_id': value['typeId'],
+        'name': value['name'],
+        'claims': value['claims'] == null ? undefined : ((value['claims'] as Array<any>).map(ClaimSchemaToJSON)),
+        'revocable': value['revocable'],
+        'default_ttl_seconds': value['defaultTtlSeconds'],
+    };
+}
+
@@ -50,11 +50,17 @@ export interface Event {
      */
     chainId?: string;
     /**
-     * 
+     * Assigned by the server, unique to the event so its tickets are not accepted at any other event
      * @type {string}
      * @memberof Event
      */
     contextId?: string;
+    /**
+     * External nullifier check-in proofs must be generated with, derived from the context ID
+     * @type {string}
+     * @memberof Event
+     */
+    externalNullifier?: string;
     /**
      * 
      * @type {string}
@@ -73,6 +79,90 @@ export interface Event {
      * @memberof Event
      */
     endDate?: Date;
+    /**
+     * Whether a ticket can be scanned more than once
+     * @type {boolean}
+     * @memberof Event
+     */
+    allowReentry?: boolean;
+    /**
+     * Whether check-in proofs reveal the ticket ID so that revoked tickets are rejected
+     * @type {boolean}
+     * @memberof Event
+     */
+    revocationCheck?: boolean;
+    /**
+     * 
+     * @type {string}
+     * @memberof Event
+     */
+    organizationId?: string;
+    /**
+     * Maximum number of registrations, 0 if the event has no limit
+     * @type {number}
+     * @memberof Event
+     */
+    capacity?: number;
+    /**
+     * invite_only, open or domain. Invite only events are registered by the organizer, anyone can register for open events and domain events accept the allowed email domains
+     * @type {string}
+     * @memberof Event
+     */
+    registrationMode?: string;
+    /**
+     * 
+     * @type {Array<string>}
+     * @memberof Event
+     */
+    allowedEmailDomains?: Array<string>;
+    /**
+     * Time registration closes, the end of the event if no deadline was set
+     * @type {Date}
+     * @memberof Event
+     */
+    registrationDeadline?: Date;
+    /**
+     * draft, published, cancelled or archived
+     * @type {string}
+     * @memberof Event
+     */
+    status?: string;
+    /**
+     * Time a published event is listed from, it is listed right away if not set
+     * @type {Date}
+     * @memberof Event
+     */
+    publishAt?: Date;
+    /**
+     * fixed_ttl if tickets are valid for a fixed time from issuance, event_end if they expire at the end of the event
+     * @type {string}
+     * @memberof Event
+     */
+    ticketExpiryPolicy?: string;
+    /**
+     * Validity of tickets for fixed_ttl, or the grace period after the end of the event for event_end
+     * @type {number}
+     * @memberof Event
+     */
+    ticketValiditySeconds?: number;
+    /**
+     * Whether tickets carry the tier of the attendee as a scalar claim
+     * @type {boolean}
+     * @memberof Event
+     */
+    tieredTickets?: boolean;
+    /**
+     * Credential type of the tickets of the event in the credential type registry
+     * @type {string}
+     * @memberof Event
+     */
+    ticketTypeId?: string;
+    /**
+     * Credential types organizers can issue to registrants of the event
+     * @type {Array<string>}
+     * @memberof Event
+     */
+    credentialTypeIds?: Array<string>;
 }
 
 /**
@@ -98,9 +188,24 @@ export function EventFromJSONTyped(json: any, ignoreDiscriminator: boolean): Eve
         'url': json['url'] == null ? undefined : json['url'],
         'chainId': json['chain_id'] == null ? undefined : json['chain_id'],
         'contextId': json['context_id'] == null ? undefined : json['context_id'],
+        'externalNullifier': json['external_nullifier'] == null ? undefined : json['external_nullifier'],
         'issuerKeyId': json['issuer_key_id'] == null ? undefined : json['issuer_key_id'],
         'startDate': json['start_date'] == null ? undefined : (new Date(json['start_date'])),
         'endDate': json['end_date'] == null ? undefined : (new Date(json['end_date'])),
+        'allowReentry': json['allow_reentry'] == null ? undefined : json['allow_reentry'],
+        'revocationCheck': json['revocation_check'] == null ? undefined : json['revocation_check'],
+        'organizationId': json['organization_id'] == null ? undefined : json['organization_id'],
+        'capacity': json['capacity'] == null ? undefined : json['capacity'],
+        'registrationMode': json['registration_mode'] == null ? undefined : json['registration_mode'],
+        'allowedEmailDomains': json['allowed_email_domains'] == null ? undefined : json['allowed_email_domains'],
+        'registrationDeadline': json['registration_deadline'] == null ? undefined : (new Date(json['registration_deadline'])),
+        'status': json['status'] == null ? undefined : json['status'],
+        'publishAt': json['publish_at'] == null ? undefined : (new Date(json['publish_at'])),
+        'ticketExpiryPolicy': json['ticket_expiry_policy'] == null ? undefined : json['ticket_expiry_policy'],
+        'ticketValiditySeconds': json['ticket_validity_seconds'] == null ? undefined : json['ticket_validity_seconds'],
+        'tieredTickets': json['tiered_tickets'] == null ? undefined : json['tiered_tickets'],
+        'ticketTypeId': json['ticket_type_id'] == null ? undefined : json['ticket_type_id'],
+        'credentialTypeIds': json['credential_type_ids'] == null ? undefined : json['credential_type_ids'],
     };
 }
 
@@ -116,9 +221,24 @@ export function EventToJSON(value?: Event | null): any {
         'url': value['url'],
         'chain_id': value['chainId'],
         'context_id': value['contextId'],
+        'external_nullifier': value['externalNullifier'],
         'issuer_key_id': value['issuerKeyId'],
         'start_date': value['startDate'] == null ? undefined : ((value['startDate']).toISOString()),
         'end_date': value['endDate'] == null ? undefined : ((value['endDate']).toISOString()),
+        'allow_reentry': value['allowReentry'],
+        'revocation_check': value['revocationCheck'],
+        'organization_id': value['organizationId'],
+        'capacity': value['capacity'],
+        'registration_mode': value['registrationMode'],
+        'allowed_email_domains': value['allowedEmailDomains'],
+        'registration_deadline': value['registrationDeadline'] == null ? undefined : ((value['registrationDeadline']).toISOString()),
+        'status': value['status'],
+        'publish_at': value['publishAt'] == null ? undefined : ((value['publishAt']).toISOString()),
+        'ticket_expiry_policy': value['ticketExpiryPolicy'],
+        'ticket_validity_seconds': value['ticketValiditySeconds'],
+        'tiered_tickets': value['tieredTickets'],
+        'ticket_type_id': value['ticketTypeId'],
+        'credential_type_ids': value['credentialTypeIds'],
     };
 }
 
@@ -0,0 +1,92 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface EventCredential
+ */
+export interface EventCredential {
+    /**
+     * 
+     * @type {string}
+     * @memberof EventCredential
+     */
+    eventId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventCredential
+     */
+    typeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventCredential
+     */
+    credential?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof EventCredential
+     */
+    issuedAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof EventCredential
+     */
+    expireAt?: Date;
+}
+
+/**
+ * Check if a given object implements the EventCredential interface.
+ */
+export function instanceOfEventCredential(value: object): value is EventCredential {
+    return true;
+}
+
+export function EventCredentialFromJSON(json: any): EventCredential {
+    return EventCredentialFromJSONTyped(json, false);
+}
+
+export function EventCredentialFromJSONTyped(json: any, ignoreDiscriminator: boolean): EventCredential {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'typeId': json['type_id'] == null ? undefined : json['type_id'],
+        'credential': json['credential'] == null ? undefined : json['credential'],
+        'issuedAt': json['issued_at'] == null ? undefined : (new Date(json['issued_at'])),
+        'expireAt': json['expire_at'] == null ? undefined : (new Date(json['expire_at'])),
+    };
+}
+
+export function EventCredentialToJSON(value?: EventCredential | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'event_id': value['eventId'],
+        'type_id': value['typeId'],
+        'credential': value['credential'],
+        'issued_at': value['issuedAt'] == null ? undefined : ((value['issuedAt']).toISOString()),
+        'expire_at': value['expireAt'] == null ? undefined : ((value['expireAt']).toISOString()),
+    };
+}
+
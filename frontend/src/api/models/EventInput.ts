@@ -0,0 +1,228 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface EventInput
+ */
+export interface EventInput {
+    /**
+     * 
+     * @type {string}
+     * @memberof EventInput
+     */
+    name?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventInput
+     */
+    description?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventInput
+     */
+    url?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventInput
+     */
+    chainId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventInput
+     */
+    issuerKeyId?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof EventInput
+     */
+    startDate?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof EventInput
+     */
+    endDate?: Date;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof EventInput
+     */
+    allowReentry?: boolean;
+    /**
+     * Reject revoked tickets at check-in. Check-in proofs then reveal the ticket ID, which the server can link to the email the ticket was issued to, so attendance is no longer anonymous to the organization. Without it revoked tickets are still accepted. Needs a revocable ticket credential type
+     * @type {boolean}
+     * @memberof EventInput
+     */
+    revocationCheck?: boolean;
+    /**
+     * Verification key of the check-in circuit
+     * @type {string}
+     * @memberof EventInput
+     */
+    verificationKey?: string;
+    /**
+     * Organization that manages the event, ignored on update
+     * @type {string}
+     * @memberof EventInput
+     */
+    organizationId?: string;
+    /**
+     * Maximum number of registrations, 0 for no limit. People on the waitlist are registered when places become free
+     * @type {number}
+     * @memberof EventInput
+     */
+    capacity?: number;
+    /**
+     * invite_only, open or domain, invite_only if empty
+     * @type {string}
+     * @memberof EventInput
+     */
+    registrationMode?: string;
+    /**
+     * Email domains that can register and request tickets, such as example.com. Every domain is allowed if empty, domain events need at least one
+     * @type {Array<string>}
+     * @memberof EventInput
+     */
+    allowedEmailDomains?: Array<string>;
+    /**
+     * Time registration closes, registration closes at the end of the event if not set
+     * @type {Date}
+     * @memberof EventInput
+     */
+    registrationDeadline?: Date;
+    /**
+     * draft, published, cancelled or archived. New events are drafts if empty, the status is kept on update if empty
+     * @type {string}
+     * @memberof EventInput
+     */
+    status?: string;
+    /**
+     * Time a published event is listed from, for scheduled publishing. It is listed right away if not set
+     * @type {Date}
+     * @memberof EventInput
+     */
+    publishAt?: Date;
+    /**
+     * fixed_ttl or event_end, event_end if empty
+     * @type {string}
+     * @memberof EventInput
+     */
+    ticketExpiryPolicy?: string;
+    /**
+     * Validity of tickets for fixed_ttl, required. For event_end, the grace period after the end of the event, 0 for none
+     * @type {number}
+     * @memberof EventInput
+     */
+    ticketValiditySeconds?: number;
+    /**
+     * Issue tickets carrying the tier of the attendee as a scalar claim, so scanners can check a minimum tier. The verification key must be the one of the scalar circuit
+     * @type {boolean}
+     * @memberof EventInput
+     */
+    tieredTickets?: boolean;
+    /**
+     * Credential type of the tickets in the registry, a primitive type or one of the organization. Tiered tickets need a type with a single scalar claim and the others a type without claims. Defaults to the first primitive type that fits
+     * @type {string}
+     * @memberof EventInput
+     */
+    ticketTypeId?: string;
+    /**
+     * Credential types organizers can issue to registrants of the event, primitive types or types of the organization. The ticket type of the event cannot be one of them, so that credentials never pass check-in
+     * @type {Array<string>}
+     * @memberof EventInput
+     */
+    credentialTypeIds?: Array<string>;
+}
+
+/**
+ * Check if a given object implements the EventInput interface.
+ */
+export function instanceOfEventInput(value: object): value is EventInput {
+    return true;
+}
+
+export function EventInputFromJSON(json: any): EventInput {
+    return EventInputFromJSONTyped(json, false);
+}
+
+export function EventInputFromJSONTyped(json: any, ignoreDiscriminator: boolean): EventInput {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+        'description': json['description'] == null ? undefined : json['description'],
+        'url': json['url'] == null ? undefined : json['url'],
+        'chainId': json['chain_id'] == null ? undefined : json['chain_id'],
+        'issuerKeyId': json['issuer_key_id'] == null ? undefined : json['issuer_key_id'],
+        'startDate': json['start_date'] == null ? undefined : (new Date(json['start_date'])),
+        'endDate': json['end_date'] == null ? undefined : (new Date(json['end_date'])),
+        'allowReentry': json['allow_reentry'] == null ? undefined : json['allow_reentry'],
+        'revocationCheck': json['revocation_check'] == null ? undefined : json['revocation_check'],
+        'verificationKey': json['verification_key'] == null ? undefined : json['verification_key'],
+        'organizationId': json['organization_id'] == null ? undefined : json['organization_id'],
+        'capacity': json['capacity'] == null ? undefined : json['capacity'],
+        'registrationMode': json['registration_mode'] == null ? undefined : json['registration_mode'],
+        'allowedEmailDomains': json['allowed_email_domains'] == null ? undefined : json['allowed_email_domains'],
+        'registrationDeadline': json['registration_deadline'] == null ? undefined : (new Date(json['registration_deadline'])),
+        'status': json['status'] == null ? undefined : json['status'],
+        'publishAt': json['publish_at'] == null ? undefined : (new Date(json['publish_at'])),
+        'ticketExpiryPolicy': json['ticket_expiry_policy'] == null ? undefined : json['ticket_expiry_policy'],
+        'ticketValiditySeconds': json['ticket_validity_seconds'] == null ? undefined : json['ticket_validity_seconds'],
+        'tieredTickets': json['tiered_tickets'] == null ? undefined : json['tiered_tickets'],
+        'ticketTypeId': json['ticket_type_id'] == null ? undefined : json['ticket_type_id'],
+        'credentialTypeIds': json['credential_type_ids'] == null ? undefined : json['credential_type_ids'],
+    };
+}
+
+export function EventInputToJSON(value?: EventInput | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'name': value['name'],
+        'description': value['description'],
+        'url': value['url'],
+        'chain_id': value['chainId'],
+        'issuer_key_id': value['issuerKeyId'],
+        'start_date': value['startDate'] == null ? undefined : ((value['startDate']).toISOString()),
+        'end_date': value['endDate'] == null ? undefined : ((value['endDate']).toISOString()),
+        'allow_reentry': value['allowReentry'],
+        'revocation_check': value['revocationCheck'],
+        'verification_key': value['verificationKey'],
+        'organization_id': value['organizationId'],
+        'capacity': value['capacity'],
+        'registration_mode': value['registrationMode'],
+        'allowed_email_domains': value['allowedEmailDomains'],
+        'registration_deadline': value['registrationDeadline'] == null ? undefined : ((value['registrationDeadline']).toISOString()),
+        'status': value['status'],
+        'publish_at': value['publishAt'] == null ? undefined : ((value['publishAt']).toISOString()),
+        'ticket_expiry_policy': value['ticketExpiryPolicy'],
+        'ticket_validity_seconds': value['ticketValiditySeconds'],
+        'tiered_tickets': value['tieredTickets'],
+        'ticket_type_id': value['ticketTypeId'],
+        'credential_type_ids': value['credentialTypeIds'],
+    };
+}
+
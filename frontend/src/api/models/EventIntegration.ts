@@ -0,0 +1,108 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface EventIntegration
+ */
+export interface EventIntegration {
+    /**
+     * 
+     * @type {string}
+     * @memberof EventIntegration
+     */
+    id?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventIntegration
+     */
+    eventId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventIntegration
+     */
+    provider?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventIntegration
+     */
+    externalEventId?: string;
+    /**
+     * Path the ticketing platform sends the order webhooks of the external event to
+     * @type {string}
+     * @memberof EventIntegration
+     */
+    webhookPath?: string;
+    /**
+     * Secret the ticketing platform signs the webhooks with, only orders signed with it register attendees
+     * @type {string}
+     * @memberof EventIntegration
+     */
+    secret?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof EventIntegration
+     */
+    createdAt?: Date;
+}
+
+/**
+ * Check if a given object implements the EventIntegration interface.
+ */
+export function instanceOfEventIntegration(value: object): value is EventIntegration {
+    return true;
+}
+
+export function EventIntegrationFromJSON(json: any): EventIntegration {
+    return EventIntegrationFromJSONTyped(json, false);
+}
+
+export function EventIntegrationFromJSONTyped(json: any, ignoreDiscriminator: boolean): EventIntegration {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'] == null ? undefined : json['id'],
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'provider': json['provider'] == null ? undefined : json['provider'],
+        'externalEventId': json['external_event_id'] == null ? undefined : json['external_event_id'],
+        'webhookPath': json['webhook_path'] == null ? undefined : json['webhook_path'],
+        'secret': json['secret'] == null ? undefined : json['secret'],
+        'createdAt': json['created_at'] == null ? undefined : (new Date(json['created_at'])),
+    };
+}
+
+export function EventIntegrationToJSON(value?: EventIntegration | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'event_id': value['eventId'],
+        'provider': value['provider'],
+        'external_event_id': value['externalEventId'],
+        'webhook_path': value['webhookPath'],
+        'secret': value['secret'],
+        'created_at': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+    };
+}
+
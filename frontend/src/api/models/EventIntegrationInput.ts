@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface EventIntegrationInput
+ */
+export interface EventIntegrationInput {
+    /**
+     * Ticketing platform, e.g. generic
+     * @type {string}
+     * @memberof EventIntegrationInput
+     */
+    provider?: string;
+    /**
+     * ID of the event on the ticketing platform
+     * @type {string}
+     * @memberof EventIntegrationInput
+     */
+    externalEventId?: string;
+}
+
+/**
+ * Check if a given object implements the EventIntegrationInput interface.
+ */
+export function instanceOfEventIntegrationInput(value: object): value is EventIntegrationInput {
+    return true;
+}
+
+export function EventIntegrationInputFromJSON(json: any): EventIntegrationInput {
+    return EventIntegrationInputFromJSONTyped(json, false);
+}
+
+export function EventIntegrationInputFromJSONTyped(json: any, ignoreDiscriminator: boolean): EventIntegrationInput {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'provider': json['provider'] == null ? undefined : json['provider'],
+        'externalEventId': json['external_event_id'] == null ? undefined : json['external_event_id'],
+    };
+}
+
+export function EventIntegrationInputToJSON(value?: EventIntegrationInput | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'provider': value['provider'],
+        'external_event_id': value['externalEventId'],
+    };
+}
+
@@ -0,0 +1,164 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface EventManifest
+ */
+export interface EventManifest {
+    /**
+     * 
+     * @type {string}
+     * @memberof EventManifest
+     */
+    eventId?: string;
+    /**
+     * Changes whenever any of the fields used to verify proofs change
+     * @type {string}
+     * @memberof EventManifest
+     */
+    version?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventManifest
+     */
+    contextId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventManifest
+     */
+    externalNullifier?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventManifest
+     */
+    chainId?: string;
+    /**
+     * Issuer keys accepted for the event
+     * @type {Array<string>}
+     * @memberof EventManifest
+     */
+    issuerKeyIds?: Array<string>;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventManifest
+     */
+    credentialTypeId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof EventManifest
+     */
+    verificationKey?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof EventManifest
+     */
+    startDate?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof EventManifest
+     */
+    endDate?: Date;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof EventManifest
+     */
+    allowReentry?: boolean;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof EventManifest
+     */
+    revocationCheck?: boolean;
+    /**
+     * Whether the event is cancelled or archived, scanners reject every ticket while it is set
+     * @type {boolean}
+     * @memberof EventManifest
+     */
+    closed?: boolean;
+    /**
+     * EdDSA signed JWT carrying the fields above, verified with the manifest public key. Empty if manifests are not signed
+     * @type {string}
+     * @memberof EventManifest
+     */
+    signedManifest?: string;
+}
+
+/**
+ * Check if a given object implements the EventManifest interface.
+ */
+export function instanceOfEventManifest(value: object): value is EventManifest {
+    return true;
+}
+
+export function EventManifestFromJSON(json: any): EventManifest {
+    return EventManifestFromJSONTyped(json, false);
+}
+
+export function EventManifestFromJSONTyped(json: any, ignoreDiscriminator: boolean): EventManifest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'version': json['version'] == null ? undefined : json['version'],
+        'contextId': json['context_id'] == null ? undefined : json['context_id'],
+        'externalNullifier': json['external_nullifier'] == null ? undefined : json['external_nullifier'],
+        'chainId': json['chain_id'] == null ? undefined : json['chain_id'],
+        'issuerKeyIds': json['issuer_key_ids'] == null ? undefined : json['issuer_key_ids'],
+        'credentialTypeId': json['credential_type_id'] == null ? undefined : json['credential_type_id'],
+        'verificationKey': json['verification_key'] == null ? undefined : json['verification_key'],
+        'startDate': json['start_date'] == null ? undefined : (new Date(json['start_date'])),
+        'endDate': json['end_date'] == null ? undefined : (new Date(json['end_date'])),
+        'allowReentry': json['allow_reentry'] == null ? undefined : json['allow_reentry'],
+        'revocationCheck': json['revocation_check'] == null ? undefined : json['revocation_check'],
+        'closed': json['closed'] == null ? undefined : json['closed'],
+        'signedManifest': json['signed_manifest'] == null ? undefined : json['signed_manifest'],
+    };
+}
+
+export function EventManifestToJSON(value?: EventManifest | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'event_id': value['eventId'],
+        'version': value['version'],
+        'context_id': value['contextId'],
+        'external_nullifier': value['externalNullifier'],
+        'chain_id': value['chainId'],
+        'issuer_key_ids': value['issuerKeyIds'],
+        'credential_type_id': value['credentialTypeId'],
+        'verification_key': value['verificationKey'],
+        'start_date': value['startDate'] == null ? undefined : ((value['startDate']).toISOString()),
+        'end_date': value['endDate'] == null ? undefined : ((value['endDate']).toISOString()),
+        'allow_reentry': value['allowReentry'],
+        'revocation_check': value['revocationCheck'],
+        'closed': value['closed'],
+        'signed_manifest': value['signedManifest'],
+    };
+}
+
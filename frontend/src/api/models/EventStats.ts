@@ -0,0 +1,107 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { HourlyCheckIns } from './HourlyCheckIns';
+import {
+    HourlyCheckInsFromJSON,
+    HourlyCheckInsFromJSONTyped,
+    HourlyCheckInsToJSON,
+} from './HourlyCheckIns';
+
+/**
+ * 
+ * @export
+ * @interface EventStats
+ */
+export interface EventStats {
+    /**
+     * 
+     * @type {string}
+     * @memberof EventStats
+     */
+    eventId?: string;
+    /**
+     * Number of registered emails
+     * @type {number}
+     * @memberof EventStats
+     */
+    registered?: number;
+    /**
+     * Number of ticket credentials issued on request
+     * @type {number}
+     * @memberof EventStats
+     */
+    ticketCredentialsRequested?: number;
+    /**
+     * Number of ticket credentials stored by users
+     * @type {number}
+     * @memberof EventStats
+     */
+    ticketCredentialsStored?: number;
+    /**
+     * Number of tickets checked in
+     * @type {number}
+     * @memberof EventStats
+     */
+    checkedIn?: number;
+    /**
+     * 
+     * @type {Array<HourlyCheckIns>}
+     * @memberof EventStats
+     */
+    hourlyCheckIns?: Array<HourlyCheckIns>;
+}
+
+/**
+ * Check if a given object implements the EventStats interface.
+ */
+export function instanceOfEventStats(value: object): value is EventStats {
+    return true;
+}
+
+export function EventStatsFromJSON(json: any): EventStats {
+    return EventStatsFromJSONTyped(json, false);
+}
+
+export function EventStatsFromJSONTyped(json: any, ignoreDiscriminator: boolean): EventStats {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'registered': json['registered'] == null ? undefined : json['registered'],
+        'ticketCredentialsRequested': json['ticket_credentials_requested'] == null ? undefined : json['ticket_credentials_requested'],
+        'ticketCredentialsStored': json['ticket_credentials_stored'] == null ? undefined : json['ticket_credentials_stored'],
+        'checkedIn': json['checked_in'] == null ? undefined : json['checked_in'],
+        'hourlyCheckIns': json['hourly_check_ins'] == null ? undefined : ((json['hourly_check_ins'] as Array<any>).map(HourlyCheckInsFromJSON)),
+    };
+}
+
+export function EventStatsToJSON(value?: EventStats | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'event_id': value['eventId'],
+        'registered': value['registered'],
+        'ticket_credentials_requested': value['ticketCredentialsRequested'],
+        'ticket_credentials_stored': value['ticketCredentialsStored'],
+        'checked_in': value['checkedIn'],
+        'hourly_check_ins': value['hourlyCheckIns'] == null ? undefined : ((value['hourlyCheckIns'] as Array<any>).map(HourlyCheckInsToJSON)),
+    };
+}
+
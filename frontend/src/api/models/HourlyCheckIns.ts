@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface HourlyCheckIns
+ */
+export interface HourlyCheckIns {
+    /**
+     * Start of the hour
+     * @type {Date}
+     * @memberof HourlyCheckIns
+     */
+    hour?: Date;
+    /**
+     * Number of tickets first checked in during the hour
+     * @type {number}
+     * @memberof HourlyCheckIns
+     */
+    checkIns?: number;
+}
+
+/**
+ * Check if a given object implements the HourlyCheckIns interface.
+ */
+export function instanceOfHourlyCheckIns(value: object): value is HourlyCheckIns {
+    return true;
+}
+
+export function HourlyCheckInsFromJSON(json: any): HourlyCheckIns {
+    return HourlyCheckInsFromJSONTyped(json, false);
+}
+
+export function HourlyCheckInsFromJSONTyped(json: any, ignoreDiscriminator: boolean): HourlyCheckIns {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'hour': json['hour'] == null ? undefined : (new Date(json['hour'])),
+        'checkIns': json['check_ins'] == null ? undefined : json['check_ins'],
+    };
+}
+
+export function HourlyCheckInsToJSON(value?: HourlyCheckIns | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'hour': value['hour'] == null ? undefined : ((value['hour']).toISOString()),
+        'check_ins': value['checkIns'],
+    };
+}
+
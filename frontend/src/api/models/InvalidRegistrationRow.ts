@@ -0,0 +1,76 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface InvalidRegistrationRow
+ */
+export interface InvalidRegistrationRow {
+    /**
+     * 
+     * @type {number}
+     * @memberof InvalidRegistrationRow
+     */
+    line?: number;
+    /**
+     * 
+     * @type {string}
+     * @memberof InvalidRegistrationRow
+     */
+    value?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof InvalidRegistrationRow
+     */
+    reason?: string;
+}
+
+/**
+ * Check if a given object implements the InvalidRegistrationRow interface.
+ */
+export function instanceOfInvalidRegistrationRow(value: object): value is InvalidRegistrationRow {
+    return true;
+}
+
+export function InvalidRegistrationRowFromJSON(json: any): InvalidRegistrationRow {
+    return InvalidRegistrationRowFromJSONTyped(json, false);
+}
+
+export function InvalidRegistrationRowFromJSONTyped(json: any, ignoreDiscriminator: boolean): InvalidRegistrationRow {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'line': json['line'] == null ? undefined : json['line'],
+        'value': json['value'] == null ? undefined : json['value'],
+        'reason': json['reason'] == null ? undefined : json['reason'],
+    };
+}
+
+export function InvalidRegistrationRowToJSON(value?: InvalidRegistrationRow | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'line': value['line'],
+        'value': value['value'],
+        'reason': value['reason'],
+    };
+}
+
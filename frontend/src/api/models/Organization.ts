@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface Organization
+ */
+export interface Organization {
+    /**
+     * 
+     * @type {string}
+     * @memberof Organization
+     */
+    id?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Organization
+     */
+    name?: string;
+    /**
+     * Role of the user in the organization
+     * @type {string}
+     * @memberof Organization
+     */
+    role?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Organization
+     */
+    createdAt?: Date;
+}
+
+/**
+ * Check if a given object implements the Organization interface.
+ */
+export function instanceOfOrganization(value: object): value is Organization {
+    return true;
+}
+
+export function OrganizationFromJSON(json: any): Organization {
+    return OrganizationFromJSONTyped(json, false);
+}
+
+export function OrganizationFromJSONTyped(json: any, ignoreDiscriminator: boolean): Organization {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'] == null ? undefined : json['id'],
+        'name': json['name'] == null ? undefined : json['name'],
+        'role': json['role'] == null ? undefined : json['role'],
+        'createdAt': json['created_at'] == null ? undefined : (new Date(json['created_at'])),
+    };
+}
+
+export function OrganizationToJSON(value?: Organization | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'name': value['name'],
+        'role': value['role'],
+        'created_at': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+    };
+}
+
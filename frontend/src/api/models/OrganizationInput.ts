@@ -0,0 +1,60 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface OrganizationInput
+ */
+export interface OrganizationInput {
+    /**
+     * 
+     * @type {string}
+     * @memberof OrganizationInput
+     */
+    name?: string;
+}
+
+/**
+ * Check if a given object implements the OrganizationInput interface.
+ */
+export function instanceOfOrganizationInput(value: object): value is OrganizationInput {
+    return true;
+}
+
+export function OrganizationInputFromJSON(json: any): OrganizationInput {
+    return OrganizationInputFromJSONTyped(json, false);
+}
+
+export function OrganizationInputFromJSONTyped(json: any, ignoreDiscriminator: boolean): OrganizationInput {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+    };
+}
+
+export function OrganizationInputToJSON(value?: OrganizationInput | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'name': value['name'],
+    };
+}
+
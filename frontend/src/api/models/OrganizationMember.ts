@@ -0,0 +1,84 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface OrganizationMember
+ */
+export interface OrganizationMember {
+    /**
+     * 
+     * @type {string}
+     * @memberof OrganizationMember
+     */
+    userId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof OrganizationMember
+     */
+    email?: string;
+    /**
+     * One of owner, admin or scanner
+     * @type {string}
+     * @memberof OrganizationMember
+     */
+    role?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof OrganizationMember
+     */
+    createdAt?: Date;
+}
+
+/**
+ * Check if a given object implements the OrganizationMember interface.
+ */
+export function instanceOfOrganizationMember(value: object): value is OrganizationMember {
+    return true;
+}
+
+export function OrganizationMemberFromJSON(json: any): OrganizationMember {
+    return OrganizationMemberFromJSONTyped(json, false);
+}
+
+export function OrganizationMemberFromJSONTyped(json: any, ignoreDiscriminator: boolean): OrganizationMember {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'userId': json['user_id'] == null ? undefined : json['user_id'],
+        'email': json['email'] == null ? undefined : json['email'],
+        'role': json['role'] == null ? undefined : json['role'],
+        'createdAt': json['created_at'] == null ? undefined : (new Date(json['created_at'])),
+    };
+}
+
+export function OrganizationMemberToJSON(value?: OrganizationMember | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'user_id': value['userId'],
+        'email': value['email'],
+        'role': value['role'],
+        'created_at': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+    };
+}
+
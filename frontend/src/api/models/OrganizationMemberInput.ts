@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface OrganizationMemberInput
+ */
+export interface OrganizationMemberInput {
+    /**
+     * 
+     * @type {string}
+     * @memberof OrganizationMemberInput
+     */
+    email?: string;
+    /**
+     * One of owner, admin or scanner
+     * @type {string}
+     * @memberof OrganizationMemberInput
+     */
+    role?: string;
+}
+
+/**
+ * Check if a given object implements the OrganizationMemberInput interface.
+ */
+export function instanceOfOrganizationMemberInput(value: object): value is OrganizationMemberInput {
+    return true;
+}
+
+export function OrganizationMemberInputFromJSON(json: any): OrganizationMemberInput {
+    return OrganizationMemberInputFromJSONTyped(json, false);
+}
+
+export function OrganizationMemberInputFromJSONTyped(json: any, ignoreDiscriminator: boolean): OrganizationMemberInput {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'] == null ? undefined : json['email'],
+        'role': json['role'] == null ? undefined : json['role'],
+    };
+}
+
+export function OrganizationMemberInputToJSON(value?: OrganizationMemberInput | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+        'role': value['role'],
+    };
+}
+
@@ -20,29 +20,17 @@ import { mapValues } from '../runtime';
  */
 export interface RecordAttendanceRequest {
     /**
-     * 
+     * JSON encoded BabyZK proof
      * @type {string}
      * @memberof RecordAttendanceRequest
      */
-    type?: string;
+    proof?: string;
     /**
      * 
-     * @type {string}
-     * @memberof RecordAttendanceRequest
-     */
-    context?: string;
-    /**
-     * 
-     * @type {string}
+     * @type {Array<string>}
      * @memberof RecordAttendanceRequest
      */
-    nullifier?: string;
-    /**
-     * 
-     * @type {string}
-     * @memberof RecordAttendanceRequest
-     */
-    keyId?: string;
+    publicSignals?: Array<string>;
     /**
      * 
      * @type {string}
@@ -50,11 +38,11 @@ export interface RecordAttendanceRequest {
      */
     eventId?: string;
     /**
-     * 
+     * Lowest ticket tier let in, for the entrances of tiered events such as a VIP area. Every tier is let in if empty
      * @type {string}
      * @memberof RecordAttendanceRequest
      */
-    adminCode?: string;
+    minTier?: string;
 }
 
 /**
@@ -74,12 +62,10 @@ export function RecordAttendanceRequestFromJSONTyped(json: any, ignoreDiscrimina
     }
     return {
         
-        'type': json['type'] == null ? undefined : json['type'],
-        'context': json['context'] == null ? undefined : json['context'],
-        'nullifier': json['nullifier'] == null ? undefined : json['nullifier'],
-        'keyId': json['key_id'] == null ? undefined : json['key_id'],
+        'proof': json['proof'] == null ? undefined : json['proof'],
+        'publicSignals': json['public_signals'] == null ? undefined : json['public_signals'],
         'eventId': json['event_id'] == null ? undefined : json['event_id'],
-        'adminCode': json['admin_code'] == null ? undefined : json['admin_code'],
+        'minTier': json['min_tier'] == null ? undefined : json['min_tier'],
     };
 }
 
@@ -89,12 +75,10 @@ export function RecordAttendanceRequestToJSON(value?: RecordAttendanceRequest |
     }
     return {
         
-        'type': value['type'],
-        'context': value['context'],
-        'nullifier': value['nullifier'],
-        'key_id': value['keyId'],
+        'proof': value['proof'],
+        'public_signals': value['publicSignals'],
         'event_id': value['eventId'],
-        'admin_code': value['adminCode'],
+        'min_tier': value['minTier'],
     };
 }
 
@@ -0,0 +1,60 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface RegisterScannerRequest
+ */
+export interface RegisterScannerRequest {
+    /**
+     * Label for the device, e.g. "North entrance"
+     * @type {string}
+     * @memberof RegisterScannerRequest
+     */
+    name?: string;
+}
+
+/**
+ * Check if a given object implements the RegisterScannerRequest interface.
+ */
+export function instanceOfRegisterScannerRequest(value: object): value is RegisterScannerRequest {
+    return true;
+}
+
+export function RegisterScannerRequestFromJSON(json: any): RegisterScannerRequest {
+    return RegisterScannerRequestFromJSONTyped(json, false);
+}
+
+export function RegisterScannerRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): RegisterScannerRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'name': json['name'] == null ? undefined : json['name'],
+    };
+}
+
+export function RegisterScannerRequestToJSON(value?: RegisterScannerRequest | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'name': value['name'],
+    };
+}
+
@@ -0,0 +1,76 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface Registration
+ */
+export interface Registration {
+    /**
+     * 
+     * @type {string}
+     * @memberof Registration
+     */
+    eventId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Registration
+     */
+    email?: string;
+    /**
+     * general, vip or speaker
+     * @type {string}
+     * @memberof Registration
+     */
+    tier?: string;
+}
+
+/**
+ * Check if a given object implements the Registration interface.
+ */
+export function instanceOfRegistration(value: object): value is Registration {
+    return true;
+}
+
+export function RegistrationFromJSON(json: any): Registration {
+    return RegistrationFromJSONTyped(json, false);
+}
+
+export function RegistrationFromJSONTyped(json: any, ignoreDiscriminator: boolean): Registration {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'email': json['email'] == null ? undefined : json['email'],
+        'tier': json['tier'] == null ? undefined : json['tier'],
+    };
+}
+
+export function RegistrationToJSON(value?: Registration | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'event_id': value['eventId'],
+        'email': value['email'],
+        'tier': value['tier'],
+    };
+}
+
@@ -0,0 +1,115 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { InvalidRegistrationRow } from './InvalidRegistrationRow';
+import {
+    InvalidRegistrationRowFromJSON,
+    InvalidRegistrationRowFromJSONTyped,
+    InvalidRegistrationRowToJSON,
+} from './InvalidRegistrationRow';
+
+/**
+ * 
+ * @export
+ * @interface RegistrationImportReport
+ */
+export interface RegistrationImportReport {
+    /**
+     * 
+     * @type {number}
+     * @memberof RegistrationImportReport
+     */
+    added?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof RegistrationImportReport
+     */
+    unchanged?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof RegistrationImportReport
+     */
+    removed?: number;
+    /**
+     * Tickets revoked because their registration was removed
+     * @type {number}
+     * @memberof RegistrationImportReport
+     */
+    revoked?: number;
+    /**
+     * Emails put on the waitlist because the event is full
+     * @type {number}
+     * @memberof RegistrationImportReport
+     */
+    waitlisted?: number;
+    /**
+     * Emails removed from the waitlist because they are not in the file, when syncing
+     * @type {number}
+     * @memberof RegistrationImportReport
+     */
+    removedFromWaitlist?: number;
+    /**
+     * 
+     * @type {Array<InvalidRegistrationRow>}
+     * @memberof RegistrationImportReport
+     */
+    invalid?: Array<InvalidRegistrationRow>;
+}
+
+/**
+ * Check if a given object implements the RegistrationImportReport interface.
+ */
+export function instanceOfRegistrationImportReport(value: object): value is RegistrationImportReport {
+    return true;
+}
+
+export function RegistrationImportReportFromJSON(json: any): RegistrationImportReport {
+    return RegistrationImportReportFromJSONTyped(json, false);
+}
+
+export function RegistrationImportReportFromJSONTyped(json: any, ignoreDiscriminator: boolean): RegistrationImportReport {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'added': json['added'] == null ? undefined : json['added'],
+        'unchanged': json['unchanged'] == null ? undefined : json['unchanged'],
+        'removed': json['removed'] == null ? undefined : json['removed'],
+        'revoked': json['revoked'] == null ? undefined : json['revoked'],
+        'waitlisted': json['waitlisted'] == null ? undefined : json['waitlisted'],
+        'removedFromWaitlist': json['removed_from_waitlist'] == null ? undefined : json['removed_from_waitlist'],
+        'invalid': json['invalid'] == null ? undefined : ((json['invalid'] as Array<any>).map(InvalidRegistrationRowFromJSON)),
+    };
+}
+
+export function RegistrationImportReportToJSON(value?: RegistrationImportReport | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'added': value['added'],
+        'unchanged': value['unchanged'],
+        'removed': value['removed'],
+        'revoked': value['revoked'],
+        'waitlisted': value['waitlisted'],
+        'removed_from_waitlist': value['removedFromWaitlist'],
+        'invalid': value['invalid'] == null ? undefined : ((value['invalid'] as Array<any>).map(InvalidRegistrationRowToJSON)),
+    };
+}
+
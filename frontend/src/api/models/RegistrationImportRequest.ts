@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface RegistrationImportRequest
+ */
+export interface RegistrationImportRequest {
+    /**
+     * CSV file with an "email" column, or with the emails in the first column if there is no header
+     * @type {string}
+     * @memberof RegistrationImportRequest
+     */
+    csv?: string;
+    /**
+     * Remove registrations and waitlist entries of emails that are not in the file
+     * @type {boolean}
+     * @memberof RegistrationImportRequest
+     */
+    sync?: boolean;
+}
+
+/**
+ * Check if a given object implements the RegistrationImportRequest interface.
+ */
+export function instanceOfRegistrationImportRequest(value: object): value is RegistrationImportRequest {
+    return true;
+}
+
+export function RegistrationImportRequestFromJSON(json: any): RegistrationImportRequest {
+    return RegistrationImportRequestFromJSONTyped(json, false);
+}
+
+export function RegistrationImportRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): RegistrationImportRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'csv': json['csv'] == null ? undefined : json['csv'],
+        'sync': json['sync'] == null ? undefined : json['sync'],
+    };
+}
+
+export function RegistrationImportRequestToJSON(value?: RegistrationImportRequest | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'csv': value['csv'],
+        'sync': value['sync'],
+    };
+}
+
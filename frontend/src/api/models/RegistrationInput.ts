@@ -0,0 +1,60 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface RegistrationInput
+ */
+export interface RegistrationInput {
+    /**
+     * general, vip or speaker
+     * @type {string}
+     * @memberof RegistrationInput
+     */
+    tier?: string;
+}
+
+/**
+ * Check if a given object implements the RegistrationInput interface.
+ */
+export function instanceOfRegistrationInput(value: object): value is RegistrationInput {
+    return true;
+}
+
+export function RegistrationInputFromJSON(json: any): RegistrationInput {
+    return RegistrationInputFromJSONTyped(json, false);
+}
+
+export function RegistrationInputFromJSONTyped(json: any, ignoreDiscriminator: boolean): RegistrationInput {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'tier': json['tier'] == null ? undefined : json['tier'],
+    };
+}
+
+export function RegistrationInputToJSON(value?: RegistrationInput | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'tier': value['tier'],
+    };
+}
+
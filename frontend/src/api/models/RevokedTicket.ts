@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface RevokedTicket
+ */
+export interface RevokedTicket {
+    /**
+     * ID of the ticket credential, revealed by check-in proofs
+     * @type {string}
+     * @memberof RevokedTicket
+     */
+    credentialId?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof RevokedTicket
+     */
+    revokedAt?: Date;
+}
+
+/**
+ * Check if a given object implements the RevokedTicket interface.
+ */
+export function instanceOfRevokedTicket(value: object): value is RevokedTicket {
+    return true;
+}
+
+export function RevokedTicketFromJSON(json: any): RevokedTicket {
+    return RevokedTicketFromJSONTyped(json, false);
+}
+
+export function RevokedTicketFromJSONTyped(json: any, ignoreDiscriminator: boolean): RevokedTicket {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'credentialId': json['credential_id'] == null ? undefined : json['credential_id'],
+        'revokedAt': json['revoked_at'] == null ? undefined : (new Date(json['revoked_at'])),
+    };
+}
+
+export function RevokedTicketToJSON(value?: RevokedTicket | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'credential_id': value['credentialId'],
+        'revoked_at': value['revokedAt'] == null ? undefined : ((value['revokedAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,92 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface Scanner
+ */
+export interface Scanner {
+    /**
+     * 
+     * @type {string}
+     * @memberof Scanner
+     */
+    id?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Scanner
+     */
+    eventId?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof Scanner
+     */
+    name?: string;
+    /**
+     * 
+     * @type {boolean}
+     * @memberof Scanner
+     */
+    revoked?: boolean;
+    /**
+     * 
+     * @type {Date}
+     * @memberof Scanner
+     */
+    createdAt?: Date;
+}
+
+/**
+ * Check if a given object implements the Scanner interface.
+ */
+export function instanceOfScanner(value: object): value is Scanner {
+    return true;
+}
+
+export function ScannerFromJSON(json: any): Scanner {
+    return ScannerFromJSONTyped(json, false);
+}
+
+export function ScannerFromJSONTyped(json: any, ignoreDiscriminator: boolean): Scanner {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'] == null ? undefined : json['id'],
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'name': json['name'] == null ? undefined : json['name'],
+        'revoked': json['revoked'] == null ? undefined : json['revoked'],
+        'createdAt': json['created_at'] == null ? undefined : (new Date(json['created_at'])),
+    };
+}
+
+export function ScannerToJSON(value?: Scanner | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'event_id': value['eventId'],
+        'name': value['name'],
+        'revoked': value['revoked'],
+        'created_at': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,83 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+import type { Scanner } from './Scanner';
+import {
+    ScannerFromJSON,
+    ScannerFromJSONTyped,
+    ScannerToJSON,
+} from './Scanner';
+
+/**
+ * 
+ * @export
+ * @interface ScannerCredential
+ */
+export interface ScannerCredential {
+    /**
+     * 
+     * @type {Scanner}
+     * @memberof ScannerCredential
+     */
+    scanner?: Scanner;
+    /**
+     * Bearer token used by the scanner device to record attendance
+     * @type {string}
+     * @memberof ScannerCredential
+     */
+    token?: string;
+    /**
+     * Base64 encoded Ed25519 key used to verify signed manifests, empty if manifests are not signed
+     * @type {string}
+     * @memberof ScannerCredential
+     */
+    manifestPublicKey?: string;
+}
+
+/**
+ * Check if a given object implements the ScannerCredential interface.
+ */
+export function instanceOfScannerCredential(value: object): value is ScannerCredential {
+    return true;
+}
+
+export function ScannerCredentialFromJSON(json: any): ScannerCredential {
+    return ScannerCredentialFromJSONTyped(json, false);
+}
+
+export function ScannerCredentialFromJSONTyped(json: any, ignoreDiscriminator: boolean): ScannerCredential {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'scanner': json['scanner'] == null ? undefined : ScannerFromJSON(json['scanner']),
+        'token': json['token'] == null ? undefined : json['token'],
+        'manifestPublicKey': json['manifest_public_key'] == null ? undefined : json['manifest_public_key'],
+    };
+}
+
+export function ScannerCredentialToJSON(value?: ScannerCredential | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'scanner': ScannerToJSON(value['scanner']),
+        'token': value['token'],
+        'manifest_public_key': value['manifestPublicKey'],
+    };
+}
+
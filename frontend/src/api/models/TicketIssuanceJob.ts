@@ -0,0 +1,140 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface TicketIssuanceJob
+ */
+export interface TicketIssuanceJob {
+    /**
+     * 
+     * @type {string}
+     * @memberof TicketIssuanceJob
+     */
+    id?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof TicketIssuanceJob
+     */
+    eventId?: string;
+    /**
+     * running, completed or failed
+     * @type {string}
+     * @memberof TicketIssuanceJob
+     */
+    status?: string;
+    /**
+     * Registrants the job issues tickets to
+     * @type {number}
+     * @memberof TicketIssuanceJob
+     */
+    total?: number;
+    /**
+     * 
+     * @type {number}
+     * @memberof TicketIssuanceJob
+     */
+    issued?: number;
+    /**
+     * Registrants without an identity commitment or who already have a ticket
+     * @type {number}
+     * @memberof TicketIssuanceJob
+     */
+    skipped?: number;
+    /**
+     * Registrants whose ticket still failed after every retry
+     * @type {number}
+     * @memberof TicketIssuanceJob
+     */
+    failed?: number;
+    /**
+     * Why the job failed
+     * @type {string}
+     * @memberof TicketIssuanceJob
+     */
+    error?: string;
+    /**
+     * 
+     * @type {Date}
+     * @memberof TicketIssuanceJob
+     */
+    createdAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof TicketIssuanceJob
+     */
+    updatedAt?: Date;
+    /**
+     * 
+     * @type {Date}
+     * @memberof TicketIssuanceJob
+     */
+    completedAt?: Date;
+}
+
+/**
+ * Check if a given object implements the TicketIssuanceJob interface.
+ */
+export function instanceOfTicketIssuanceJob(value: object): value is TicketIssuanceJob {
+    return true;
+}
+
+export function TicketIssuanceJobFromJSON(json: any): TicketIssuanceJob {
+    return TicketIssuanceJobFromJSONTyped(json, false);
+}
+
+export function TicketIssuanceJobFromJSONTyped(json: any, ignoreDiscriminator: boolean): TicketIssuanceJob {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'id': json['id'] == null ? undefined : json['id'],
+        'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'status': json['status'] == null ? undefined : json['status'],
+        'total': json['total'] == null ? undefined : json['total'],
+        'issued': json['issued'] == null ? undefined : json['issued'],
+        'skipped': json['skipped'] == null ? undefined : json['skipped'],
+        'failed': json['failed'] == null ? undefined : json['failed'],
+        'error': json['error'] == null ? undefined : json['error'],
+        'createdAt': json['created_at'] == null ? undefined : (new Date(json['created_at'])),
+        'updatedAt': json['updated_at'] == null ? undefined : (new Date(json['updated_at'])),
+        'completedAt': json['completed_at'] == null ? undefined : (new Date(json['completed_at'])),
+    };
+}
+
+export function TicketIssuanceJobToJSON(value?: TicketIssuanceJob | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'id': value['id'],
+        'event_id': value['eventId'],
+        'status': value['status'],
+        'total': value['total'],
+        'issued': value['issued'],
+        'skipped': value['skipped'],
+        'failed': value['failed'],
+        'error': value['error'],
+        'created_at': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+        'updated_at': value['updatedAt'] == null ? undefined : ((value['updatedAt']).toISOString()),
+        'completed_at': value['completedAt'] == null ? undefined : ((value['completedAt']).toISOString()),
+    };
+}
+
@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface TicketRevocationRequest
+ */
+export interface TicketRevocationRequest {
+    /**
+     * 
+     * @type {string}
+     * @memberof TicketRevocationRequest
+     */
+    email?: string;
+    /**
+     * 
+     * @type {string}
+     * @memberof TicketRevocationRequest
+     */
+    reason?: string;
+}
+
+/**
+ * Check if a given object implements the TicketRevocationRequest interface.
+ */
+export function instanceOfTicketRevocationRequest(value: object): value is TicketRevocationRequest {
+    return true;
+}
+
+export function TicketRevocationRequestFromJSON(json: any): TicketRevocationRequest {
+    return TicketRevocationRequestFromJSONTyped(json, false);
+}
+
+export function TicketRevocationRequestFromJSONTyped(json: any, ignoreDiscriminator: boolean): TicketRevocationRequest {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'] == null ? undefined : json['email'],
+        'reason': json['reason'] == null ? undefined : json['reason'],
+    };
+}
+
+export function TicketRevocationRequestToJSON(value?: TicketRevocationRequest | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+        'reason': value['reason'],
+    };
+}
+
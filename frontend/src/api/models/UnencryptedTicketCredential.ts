@@ -25,6 +25,12 @@ export interface UnencryptedTicketCredential {
      * @memberof UnencryptedTicketCredential
      */
     eventId?: string;
+    /**
+     * Chain ID the credential was signed for, the chain ID of the event
+     * @type {string}
+     * @memberof UnencryptedTicketCredential
+     */
+    chainId?: string;
     /**
      * 
      * @type {string}
@@ -63,6 +69,7 @@ export function UnencryptedTicketCredentialFromJSONTyped(json: any, ignoreDiscri
     return {
         
         'eventId': json['event_id'] == null ? undefined : json['event_id'],
+        'chainId': json['chain_id'] == null ? undefined : json['chain_id'],
         'credential': json['credential'] == null ? undefined : json['credential'],
         'issuedAt': json['issued_at'] == null ? undefined : (new Date(json['issued_at'])),
         'expireAt': json['expire_at'] == null ? undefined : (new Date(json['expire_at'])),
@@ -76,6 +83,7 @@ export function UnencryptedTicketCredentialToJSON(value?: UnencryptedTicketCrede
     return {
         
         'event_id': value['eventId'],
+        'chain_id': value['chainId'],
         'credential': value['credential'],
         'issued_at': value['issuedAt'] == null ? undefined : ((value['issuedAt']).toISOString()),
         'expire_at': value['expireAt'] == null ? undefined : ((value['expireAt']).toISOString()),
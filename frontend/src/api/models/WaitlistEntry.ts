@@ -0,0 +1,76 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface WaitlistEntry
+ */
+export interface WaitlistEntry {
+    /**
+     * 
+     * @type {string}
+     * @memberof WaitlistEntry
+     */
+    email?: string;
+    /**
+     * 
+     * @type {number}
+     * @memberof WaitlistEntry
+     */
+    position?: number;
+    /**
+     * 
+     * @type {Date}
+     * @memberof WaitlistEntry
+     */
+    createdAt?: Date;
+}
+
+/**
+ * Check if a given object implements the WaitlistEntry interface.
+ */
+export function instanceOfWaitlistEntry(value: object): value is WaitlistEntry {
+    return true;
+}
+
+export function WaitlistEntryFromJSON(json: any): WaitlistEntry {
+    return WaitlistEntryFromJSONTyped(json, false);
+}
+
+export function WaitlistEntryFromJSONTyped(json: any, ignoreDiscriminator: boolean): WaitlistEntry {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'email': json['email'] == null ? undefined : json['email'],
+        'position': json['position'] == null ? undefined : json['position'],
+        'createdAt': json['created_at'] == null ? undefined : (new Date(json['created_at'])),
+    };
+}
+
+export function WaitlistEntryToJSON(value?: WaitlistEntry | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'email': value['email'],
+        'position': value['position'],
+        'created_at': value['createdAt'] == null ? undefined : ((value['createdAt']).toISOString()),
+    };
+}
+
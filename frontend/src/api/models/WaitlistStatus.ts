@@ -0,0 +1,68 @@
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Proof Pass API
+ * No description provided (generated by Openapi Generator https://github.com/openapitools/openapi-generator)
+ *
+ * The version of the OpenAPI document: 0.1.0
+ * 
+ *
+ * NOTE: This class is auto generated by OpenAPI Generator (https://openapi-generator.tech).
+ * https://openapi-generator.tech
+ * Do not edit the class manually.
+ */
+
+import { mapValues } from '../runtime';
+/**
+ * 
+ * @export
+ * @interface WaitlistStatus
+ */
+export interface WaitlistStatus {
+    /**
+     * registered or waitlisted
+     * @type {string}
+     * @memberof WaitlistStatus
+     */
+    status?: string;
+    /**
+     * Position on the waitlist, 0 once registered
+     * @type {number}
+     * @memberof WaitlistStatus
+     */
+    position?: number;
+}
+
+/**
+ * Check if a given object implements the WaitlistStatus interface.
+ */
+export function instanceOfWaitlistStatus(value: object): value is WaitlistStatus {
+    return true;
+}
+
+export function WaitlistStatusFromJSON(json: any): WaitlistStatus {
+    return WaitlistStatusFromJSONTyped(json, false);
+}
+
+export function WaitlistStatusFromJSONTyped(json: any, ignoreDiscriminator: boolean): WaitlistStatus {
+    if (json == null) {
+        return json;
+    }
+    return {
+        
+        'status': json['status'] == null ? undefined : json['status'],
+        'position': json['position'] == null ? undefined : json['position'],
+    };
+}
+
+export function WaitlistStatusToJSON(value?: WaitlistStatus | null): any {
+    if (value == null) {
+        return value;
+    }
+    return {
+        
+        'status': value['status'],
+        'position': value['position'],
+    };
+}
+
@@ -1,15 +1,52 @@
 /* tslint:disable */
 /* eslint-disable */
+export * from './Attendance';
+export * from './AttendanceStreamTicket';
+export * from './BatchAttendanceItem';
+export * from './BatchAttendanceRequest';
+export * from './BatchAttendanceResponse';
+export * from './BatchAttendanceResult';
+export * from './ClaimSchema';
+export * from './CredentialIssuanceReport';
+export * from './CredentialIssuanceRequest';
+export * from './CredentialIssuanceResult';
+export * from './CredentialRecipient';
+export * from './CredentialType';
+export * from './CredentialTypeInput';
 export * from './EmailCredential';
 export * from './Event';
+export * from './EventCredential';
+export * from './EventInput';
+export * from './EventIntegration';
+export * from './EventIntegrationInput';
+export * from './EventManifest';
+export * from './EventStats';
+export * from './HourlyCheckIns';
+export * from './InvalidRegistrationRow';
 export * from './LoginResponse';
+export * from './Organization';
+export * from './OrganizationInput';
+export * from './OrganizationMember';
+export * from './OrganizationMemberInput';
 export * from './PutEmailCredentialRequest';
 export * from './PutTicketCredentialRequest';
 export * from './RecordAttendanceRequest';
+export * from './RegisterScannerRequest';
+export * from './Registration';
+export * from './RegistrationImportReport';
+export * from './RegistrationImportRequest';
+export * from './RegistrationInput';
+export * from './RevokedTicket';
+export * from './Scanner';
+export * from './ScannerCredential';
 export * from './TicketCredential';
+export * from './TicketIssuanceJob';
+export * from './TicketRevocationRequest';
 export * from './UnencryptedEmailCredential';
 export * from './UnencryptedTicketCredential';
 export * from './User';
 export * from './UserEmailVerificationRequest';
 export * from './UserLogin';
 export * from './UserUpdate';
+export * from './WaitlistEntry';
+export * from './WaitlistStatus';
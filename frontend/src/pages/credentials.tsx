@@ -5,9 +5,12 @@ import Image from 'next/image';
 import withAuth from '@/components/withAuth';
 import { DefaultApi, Configuration, FetchAPI, TicketCredential } from '@/api';
 import { getToken } from '@/utils/auth';
-import { decryptValueUtf8 } from '@/utils/utils';
+import { decryptValueUtf8, encryptValue } from '@/utils/utils';
 import JsonDisplay from '@/components/JsonDisplay';
 
+// The email domain credential is kept on the device, encrypted with the password of the user
+const emailDomainCredentialKey = 'email_domain_credential';
+
 const CredentialsPage: React.FC = () => {
     const router = useRouter();
     const [ticketCredentials, setTicketCredentials] = useState<
@@ -19,6 +22,12 @@ const CredentialsPage: React.FC = () => {
     const [displayedCredentials, setDisplayedCredentials] = useState<
         Record<string, Record<string, unknown> | null>
     >({});
+    const [domainCredential, setDomainCredential] = useState<Record<
+        string,
+        unknown
+    > | null>(null);
+    const [isRequestingDomainCredential, setIsRequestingDomainCredential] =
+        useState(false);
     const api = useMemo(() => {
         const token = getToken();
         const customFetch: FetchAPI = async (
@@ -79,6 +88,43 @@ const CredentialsPage: React.FC = () => {
         fetchTicketCredentials();
     }, [api]);
 
+    useEffect(() => {
+        const stored = localStorage.getItem(emailDomainCredentialKey);
+        if (!stored) return;
+        try {
+            const hashedPassword = localStorage.getItem('auth_password');
+            const cred = hashedPassword
+                ? decryptValueUtf8(stored, hashedPassword)
+                : stored;
+            setDomainCredential(JSON.parse(cred));
+        } catch (error) {
+            console.error('Error decrypting email domain credential:', error);
+        }
+    }, []);
+
+    // The email credential stays a claimless unit credential, the domain is proven with a property
+    // credential of its own, holding the hashed domain of the email
+    const handleRequestDomainCredential = async () => {
+        setIsRequestingDomainCredential(true);
+        try {
+            const resp = await api.userMeRequestEmailDomainCredentialPost();
+            const cred = resp.credential ?? '';
+            const hashedPassword = localStorage.getItem('auth_password');
+            localStorage.setItem(
+                emailDomainCredentialKey,
+                hashedPassword ? encryptValue(cred, hashedPassword) : cred,
+            );
+            setDomainCredential(JSON.parse(cred));
+        } catch (error) {
+            console.error('Error requesting email domain credential:', error);
+            setError(
+                'Failed to request email domain credential. Please try again later.',
+            );
+        } finally {
+            setIsRequestingDomainCredential(false);
+        }
+    };
+
     const handleDisplayCredential = (ticket: TicketCredential) => {
         const eventId = ticket.eventId || 'unknown';
         if (ticket.data) {
@@ -199,6 +245,30 @@ const CredentialsPage: React.FC = () => {
                         )}
                     </CredentialSection>
                 )}
+                <Title>Email Domain Credential</Title>
+                <CredentialSection>
+                    <CredentialItem>
+                        <p>
+                            Proves the domain of your email, such as your
+                            employer, without revealing the address.
+                        </p>
+                        <DisplayCredentialButton
+                            onClick={handleRequestDomainCredential}
+                            disabled={isRequestingDomainCredential}
+                        >
+                            {isRequestingDomainCredential
+                                ? 'Requesting...'
+                                : domainCredential
+                                  ? 'Renew Credential'
+                                  : 'Request Credential'}
+                        </DisplayCredentialButton>
+                        {domainCredential && (
+                            <CredentialDisplay>
+                                <JsonDisplay data={domainCredential} />
+                            </CredentialDisplay>
+                        )}
+                    </CredentialItem>
+                </CredentialSection>
             </MainContainer>
             <Footer>
                 <Image
@@ -8,7 +8,6 @@ import {
     prepare,
     evm,
     credential,
-    credType,
     babyzkTypes,
     babyzk,
 } from '@galxe-identity-protocol/sdk';
@@ -16,6 +15,15 @@ import { ethers } from 'ethers';
 import { DefaultApi, Configuration, Event } from '@/api';
 import { getToken } from '@/utils/auth';
 
+const scannerTokenKey = (eventId: string) => `scanner_token:${eventId}`;
+const scannerMinTierKey = (eventId: string) => `scanner_min_tier:${eventId}`;
+
+// Statuses of events that no longer check tickets in
+const closedStatuses = ['cancelled', 'archived'];
+
+// Ticket tiers of tiered events, from the lowest
+const ticketTiers = ['general', 'vip', 'speaker'];
+
 interface ScannerError extends Error {
     status?: number;
     body?: {
@@ -26,18 +34,19 @@ interface ScannerError extends Error {
 const CheckInPage: React.FC = () => {
     const router = useRouter();
     const { eventId } = router.query;
-    const [adminCode, setAdminCode] = useState('');
+    const [scannerToken, setScannerToken] = useState('');
     const [isHostLoggedIn, setIsHostLoggedIn] = useState(false);
     const [eventName, setEventName] = useState<string>('');
     const [event, setEvent] = useState<Event | null>(null);
     const [showLoginMessage, setShowLoginMessage] = useState(false);
     const [isFetching, setIsFetching] = useState(false);
-    const [isQuickCheckIn, setIsQuickCheckIn] = useState(false);
     const [eventDetailsFetched, setEventDetailsFetched] = useState(false);
     const [scanner, setScanner] = useState<Html5QrcodeScanner | null>(null);
     const [showPopup, setShowPopup] = useState(false);
     const [popupMessage, setPopupMessage] = useState('');
     const [popupSuccess, setPopupSuccess] = useState(false);
+    // lowest ticket tier this entrance lets in, every tier if empty
+    const [minTier, setMinTier] = useState('');
 
     const unauthenticatedApi = useMemo(() => {
         return new DefaultApi(
@@ -50,15 +59,60 @@ const CheckInPage: React.FC = () => {
         );
     }, []);
 
-    const autoLogin = useCallback(
-        async (code: string) => {
-            if (event && code) {
+    // register this device with the session of an organization admin, for a token scoped to the device
+    const registerScanner = useCallback(
+        async (eventId: string) => {
+            const token = getToken();
+            if (!token) {
+                setPopupMessage(
+                    'Please log in as an admin of the event organization to register this device.',
+                );
+                setPopupSuccess(false);
+                setShowPopup(true);
+                return;
+            }
+            try {
+                const api = new DefaultApi(
+                    new Configuration({
+                        headers: {
+                            'Content-Type': 'application/json',
+                            Authorization: `Bearer ${token}`,
+                        },
+                        credentials: 'omit',
+                    }),
+                );
+                const credential = await api.eventsEventIdScannersPost({
+                    eventId,
+                    registerScannerRequest: {
+                        name: navigator.userAgent,
+                    },
+                });
+                localStorage.setItem(
+                    scannerTokenKey(eventId),
+                    credential.token!,
+                );
+                setScannerToken(credential.token!);
                 setIsHostLoggedIn(true);
+            } catch (error) {
+                console.error('Error registering scanner:', error);
+                setPopupMessage(
+                    'Failed to register this device. Only admins of the event organization can register scanners.',
+                );
+                setPopupSuccess(false);
+                setShowPopup(true);
             }
         },
-        [event],
+        [],
     );
 
+    const handleMinTierChange = (e: React.ChangeEvent<HTMLSelectElement>) => {
+        setMinTier(e.target.value);
+        localStorage.setItem(
+            scannerMinTierKey(eventId as string),
+            e.target.value,
+        );
+    };
+
     const onScanFailure = (error: string) => {
         // console.warn(`Code scan error = ${error}`);
     };
@@ -67,17 +121,15 @@ const CheckInPage: React.FC = () => {
         event?: React.MouseEvent<HTMLButtonElement>,
     ) => {
         event?.preventDefault();
-        if (!adminCode) {
-            setPopupMessage('Please enter an admin code');
-            setPopupSuccess(false);
-            setShowPopup(true);
-            return;
-        }
-        await autoLogin(adminCode);
+        await registerScanner(eventId as string);
     };
 
     const verifyProof = useCallback(
         async (proof: babyzkTypes.WholeProof): Promise<true | string> => {
+            // cancelled and archived events no longer check tickets in
+            if (closedStatuses.includes(event!.status ?? '')) {
+                return `This event is ${event!.status}, tickets are no longer checked in.`;
+            }
             try {
                 const provider = new ethers.JsonRpcProvider(
                     'https://cloudflare-eth.com',
@@ -86,7 +138,8 @@ const CheckInPage: React.FC = () => {
                     signerOrProvider: provider,
                 });
 
-                const expectedTypeID = credType.primitiveTypes.unit.type_id;
+                // tickets are of the credential type the event issues
+                const expectedTypeID = BigInt(event!.ticketTypeId!);
                 console.log(event!.contextId!);
                 const expectedContextID = BigInt(event!.contextId!);
                 const expectedIssuerID = BigInt(event!.issuerKeyId!);
@@ -108,6 +161,18 @@ const CheckInPage: React.FC = () => {
                     return 'This ticket is for a different event. Please check and try again.';
                 }
 
+                const actualExternalNullifier =
+                    babyzk.defaultPublicSignalGetter(
+                        credential.IntrinsicPublicSignal.ExternalNullifier,
+                        proof,
+                    );
+                if (
+                    actualExternalNullifier !==
+                    BigInt(event!.externalNullifier!)
+                ) {
+                    return 'This ticket proof was not generated for this event. Please generate a new one.';
+                }
+
                 if (actualKeyId !== undefined) {
                     console.log('Actual Key ID:', actualKeyId.toString());
                 }
@@ -139,44 +204,25 @@ const CheckInPage: React.FC = () => {
         async (
             eventId: string,
             proof: babyzkTypes.WholeProof,
-            adminCode: string,
+            scannerToken: string,
+            minTier: string,
         ): Promise<true | string> => {
             try {
-                const publicSignals = babyzk.defaultPublicSignalGetter;
-
-                const token = getToken();
                 const headers: { [key: string]: string } = {
                     'Content-Type': 'application/json',
+                    Authorization: `Bearer ${scannerToken}`,
                 };
 
-                if (token) {
-                    headers['Authorization'] = `Bearer ${token}`;
-                }
-
                 const apiConfig = new Configuration({ headers });
                 const authenticatedApi = new DefaultApi(apiConfig);
 
                 await authenticatedApi.eventsEventIdAttendancePost({
                     eventId,
                     recordAttendanceRequest: {
-                        type: credType.primitiveTypes.unit.type_id.toString(),
-                        context:
-                            publicSignals(
-                                credential.IntrinsicPublicSignal.Context,
-                                proof,
-                            )?.toString() || '',
-                        nullifier:
-                            publicSignals(
-                                credential.IntrinsicPublicSignal.Nullifier,
-                                proof,
-                            )?.toString() || '',
-                        keyId:
-                            publicSignals(
-                                credential.IntrinsicPublicSignal.KeyId,
-                                proof,
-                            )?.toString() || '',
+                        proof: JSON.stringify(proof.proof),
+                        publicSignals: proof.publicSignals,
                         eventId: eventId,
-                        adminCode: adminCode,
+                        minTier: minTier || undefined,
                     },
                 });
 
@@ -188,11 +234,17 @@ const CheckInPage: React.FC = () => {
                     const scannerError = error as ScannerError;
                     switch (scannerError.status) {
                         case 401:
-                            return 'Invalid admin code. Please enter the correct code.';
+                            localStorage.removeItem(scannerTokenKey(eventId));
+                            return 'This device is no longer authorized to check in. Please log in again.';
                         case 404:
                             return 'Event not found. Please check the event details and try again.';
                         case 400:
-                            return 'Invalid credential type. Please check your ticket and try again.';
+                            return 'Invalid or expired ticket. Please check your ticket and try again.';
+                        case 403:
+                            return (
+                                scannerError.body?.message ||
+                                'This ticket was not issued by the event issuer or its tier is not let in.'
+                            );
                         case 409:
                             return 'Attendance was previously recorded for this event.';
                         default:
@@ -218,9 +270,14 @@ const CheckInPage: React.FC = () => {
                 const proof: babyzkTypes.WholeProof = JSON.parse(decodedText);
                 const verificationResult = await verifyProof(proof);
 
-                if (verificationResult) {
+                if (verificationResult === true) {
                     if (isHostLoggedIn) {
-                        await recordAttendance(event.id!, proof, adminCode);
+                        await recordAttendance(
+                            event.id!,
+                            proof,
+                            scannerToken,
+                            minTier,
+                        );
                         setPopupMessage('Verified, attendance recorded!');
                         setPopupSuccess(true);
                     } else {
@@ -254,14 +311,17 @@ const CheckInPage: React.FC = () => {
                 setShowPopup(true);
             }
         },
-        [event, isHostLoggedIn, adminCode, verifyProof, recordAttendance],
+        [
+            event,
+            isHostLoggedIn,
+            scannerToken,
+            minTier,
+            verifyProof,
+            recordAttendance,
+        ],
     );
 
     const handleGoBack = async () => {
-        if (isQuickCheckIn) {
-            return;
-        }
-
         const token = getToken();
         if (!token) {
             setShowLoginMessage(true);
@@ -275,7 +335,7 @@ const CheckInPage: React.FC = () => {
 
     useEffect(() => {
         const fetchEventDetails = async () => {
-            const { eventId, 'admin-code': urlAdminCode } = router.query;
+            const { eventId } = router.query;
 
             if (eventId && !isFetching && !eventDetailsFetched) {
                 setIsFetching(true);
@@ -288,11 +348,18 @@ const CheckInPage: React.FC = () => {
                     setEventName(event.name!);
                     setEventDetailsFetched(true);
 
-                    if (urlAdminCode) {
-                        setIsQuickCheckIn(true);
-                        setAdminCode(urlAdminCode as string);
-                        await autoLogin(urlAdminCode as string);
+                    const storedToken = localStorage.getItem(
+                        scannerTokenKey(eventId as string),
+                    );
+                    if (storedToken) {
+                        setScannerToken(storedToken);
+                        setIsHostLoggedIn(true);
                     }
+                    setMinTier(
+                        localStorage.getItem(
+                            scannerMinTierKey(eventId as string),
+                        ) || '',
+                    );
                 } catch (error) {
                     console.error('Error fetching event details:', error);
                     setEventName('Unknown Event');
@@ -302,13 +369,7 @@ const CheckInPage: React.FC = () => {
             }
         };
         fetchEventDetails();
-    }, [
-        router.query,
-        unauthenticatedApi,
-        autoLogin,
-        eventDetailsFetched,
-        isFetching,
-    ]);
+    }, [router.query, unauthenticatedApi, eventDetailsFetched, isFetching]);
 
     useEffect(() => {
         if (typeof window !== 'undefined' && !scanner && eventDetailsFetched) {
@@ -341,17 +402,15 @@ const CheckInPage: React.FC = () => {
         <MainContainer>
             <GlobalStyle />
             <Header>
-                {!isQuickCheckIn && (
-                    <GoBackButton onClick={handleGoBack}>
-                        <Image
-                            src="/left-arrow.svg"
-                            alt="go back"
-                            width={20}
-                            height={20}
-                        />
-                        <span>Event Details</span>
-                    </GoBackButton>
-                )}
+                <GoBackButton onClick={handleGoBack}>
+                    <Image
+                        src="/left-arrow.svg"
+                        alt="go back"
+                        width={20}
+                        height={20}
+                    />
+                    <span>Event Details</span>
+                </GoBackButton>
                 <PlanetOverlay>
                     <Image
                         src="/planet.svg"
@@ -371,20 +430,36 @@ const CheckInPage: React.FC = () => {
                     <TitleMain>Check in for: {eventName}</TitleMain>
                 </TitleContainer>
                 <AdminContainer>
-                    <AdminCodeInput
-                        type="text"
-                        value={adminCode}
-                        onChange={(e) => setAdminCode(e.target.value)}
-                        placeholder="Enter admin code"
-                        disabled={isHostLoggedIn}
-                    />
                     <HostLoginButton
                         onClick={handleHostLogin}
                         disabled={isHostLoggedIn}
                     >
-                        {isHostLoggedIn ? 'Host Logged In' : 'Host Login'}
+                        {isHostLoggedIn
+                            ? 'Device Registered'
+                            : 'Register This Device'}
                     </HostLoginButton>
+                    {event?.tieredTickets && (
+                        <MinTierSelect
+                            value={minTier}
+                            onChange={handleMinTierChange}
+                            aria-label="Lowest ticket tier let in"
+                        >
+                            <option value="">All tiers</option>
+                            {ticketTiers.map((tier) => (
+                                <option key={tier} value={tier}>
+                                    {tier} and above
+                                </option>
+                            ))}
+                        </MinTierSelect>
+                    )}
                 </AdminContainer>
+                {event?.tieredTickets && (
+                    <MinTierNote>
+                        {minTier
+                            ? `Letting in ${minTier} tickets and above`
+                            : 'Letting in tickets of every tier'}
+                    </MinTierNote>
+                )}
                 <ScannerWrapper>
                     <div id="reader"></div>
                 </ScannerWrapper>
@@ -603,16 +678,6 @@ const AdminContainer = styled.div`
     margin-bottom: 20px;
 `;
 
-const AdminCodeInput = styled.input`
-    flex: 1;
-    padding: 10px;
-    border: 1px solid #ddd;
-    border-radius: 4px;
-    background-color: #fff;
-    color: #000;
-    margin-right: 10px;
-`;
-
 const HostLoginButton = styled.button`
     background: ${(props) => (props.disabled ? '#4ecdc4' : '#FF8151')};
     border: none;
@@ -625,6 +690,19 @@ const HostLoginButton = styled.button`
     opacity: ${(props) => (props.disabled ? 0.7 : 1)};
 `;
 
+const MinTierSelect = styled.select`
+    border: 1px solid #ccc;
+    border-radius: 4px;
+    font-size: 14px;
+    padding: 10px;
+`;
+
+const MinTierNote = styled.p`
+    color: #666;
+    font-size: 14px;
+    margin: 0 0 20px;
+`;
+
 const ScannerWrapper = styled.div`
     width: 100%;
     margin-bottom: 20px;
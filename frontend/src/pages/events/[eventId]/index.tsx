@@ -17,13 +17,12 @@ import QRCode from 'react-qr-code';
 import {
     prepare,
     credential,
-    credType,
     errors,
     user,
-    utils,
     issuer,
 } from '@galxe-identity-protocol/sdk';
 import { decryptValue, decryptValueUtf8, encryptValue } from '@/utils/utils';
+import { loadCredentialType } from '@/utils/credentialTypes';
 import { setToken } from '@/utils/auth';
 
 const EventDetailPage: React.FC = () => {
@@ -430,11 +429,13 @@ const EventDetailPage: React.FC = () => {
 
             console.log('Raw ticket data before decryption:', ticket.data);
 
-            const unitTypeSpec = credType.primitiveTypes.unit;
-            const unitType = errors.unwrap(
-                credType.createTypeFromSpec(unitTypeSpec),
+            // tickets are of the credential type the event issues, tiered events use a scalar type
+            console.log('Downloading proof generation gadgets...');
+            const { tp: ticketType, gadgets: proofGenGadgets } =
+                await loadCredentialType(event!.ticketTypeId!);
+            console.log(
+                'Credential type and proof generation gadgets loaded successfully.',
             );
-            console.log('Credential type created successfully.');
 
             const contextString = event!.contextId!;
             const contextID = credential.computeContextID(contextString);
@@ -454,30 +455,34 @@ const EventDetailPage: React.FC = () => {
 
             const cred = errors.unwrap(
                 credential.Credential.unmarshal(
-                    unitType,
+                    ticketType,
                     JSON.stringify(ticketData, null, 2),
                 ),
             );
 
-            const externalNullifier =
-                utils.computeExternalNullifier(contextString);
+            // the server only accepts proofs for the external nullifier of the event
+            const externalNullifier = event!.externalNullifier!;
             const expiredAtLowerBound = BigInt(1720663600); // TODO: simon: set this to the event end time + 1 day
 
-            const equalCheckId = BigInt(0);
+            // only reveal the ticket ID to events that check it against their revocation list,
+            // it links the check-in to the ticket
+            const equalCheckId = event!.revocationCheck
+                ? cred.header.id
+                : BigInt(0);
             const pseudonym = BigInt(0);
-            console.log('Proof generation parameters set up successfully.');
 
-            console.log('Downloading proof generation gadgets...');
-            // const proofGenGadgets =
-            //     await user.User.fetchProofGenGadgetsByTypeID(
-            //         cred.header.type,
-            //         provider,
-            //     );
-            const proofGenGadgets = await user.User.fetchProofGenGadgetByURIs(
-                'https://storage.googleapis.com/protocol-gadgets/unit/circom.wasm',
-                'https://storage.googleapis.com/protocol-gadgets/unit/circuit_final.zkey',
-            );
-            console.log('Proof generation gadgets downloaded successfully.');
+            // tiered tickets prove the tier they carry, its first claim, so that scanners of
+            // restricted entrances can check it against their minimum tier
+            const conditions = event!.tieredTickets
+                ? Object.entries(ticketData.body ?? {})
+                      .slice(0, 1)
+                      .map(([identifier, tier]) => ({
+                          identifier,
+                          operation: 'IN',
+                          value: { from: `${tier}`, to: `${tier}` },
+                      }))
+                : [];
+            console.log('Proof generation parameters set up successfully.');
 
             const proof = await u.genBabyzkProofWithQuery(
                 identityCommitment,
@@ -485,7 +490,7 @@ const EventDetailPage: React.FC = () => {
                 proofGenGadgets,
                 `
                 {
-                "conditions": [],
+                "conditions": ${JSON.stringify(conditions)},
                 "options": {
                     "expiredAtLowerBound": "${expiredAtLowerBound}",
                     "externalNullifier": "${externalNullifier}",
@@ -0,0 +1,48 @@
+import { credType, errors, evm, user } from '@galxe-identity-protocol/sdk';
+import { ethers } from 'ethers';
+
+// Gadgets of the primitive types are served under the name of the type
+const primitiveGadgetsURI = 'https://storage.googleapis.com/protocol-gadgets';
+
+// Function to find the name and spec of the primitive type with the given type ID
+function findPrimitiveType(typeID: string) {
+    return Object.entries(credType.primitiveTypes).find(
+        ([, spec]) => spec.type_id.toString() === typeID,
+    );
+}
+
+// Function to load the credential type of tickets and the gadgets generating proofs for it.
+// Types other than the primitive ones are looked up in the type registry of the protocol.
+async function loadCredentialType(typeID: string) {
+    const primitive = findPrimitiveType(typeID);
+    if (primitive) {
+        const [name, spec] = primitive;
+        const tp = errors.unwrap(credType.createTypeFromSpec(spec));
+        const gadgets = await user.User.fetchProofGenGadgetByURIs(
+            `${primitiveGadgetsURI}/${name}/circom.wasm`,
+            `${primitiveGadgetsURI}/${name}/circuit_final.zkey`,
+        );
+        return { tp, gadgets };
+    }
+
+    const provider = new ethers.JsonRpcProvider('https://cloudflare-eth.com');
+    const typeRegistry = evm.v1.createTypeRegistry({
+        signerOrProvider: provider,
+    });
+    const registered = await typeRegistry.getType(BigInt(typeID));
+    const tp = errors.unwrap(
+        credType.createTypeFromSpec({
+            type_id: BigInt(typeID),
+            definition: registered.definition,
+            description: registered.description,
+            revocable: registered.revocable,
+        }),
+    );
+    const gadgets = await user.User.fetchProofGenGadgetsByTypeID(
+        BigInt(typeID),
+        provider,
+    );
+    return { tp, gadgets };
+}
+
+export { loadCredentialType };
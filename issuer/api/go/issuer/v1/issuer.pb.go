@@ -815,6 +815,329 @@ func (x *GenerateSignedCredentialResponse) GetSignedCred() string {
 	return ""
 }
 
+type GenerateSignedCredentialsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*GenerateSignedCredentialRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *GenerateSignedCredentialsRequest) Reset() {
+	*x = GenerateSignedCredentialsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_issuer_v1_issuer_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateSignedCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSignedCredentialsRequest) ProtoMessage() {}
+
+func (x *GenerateSignedCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_issuer_v1_issuer_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSignedCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSignedCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_issuer_v1_issuer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GenerateSignedCredentialsRequest) GetRequests() []*GenerateSignedCredentialRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type GenerateSignedCredentialResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SignedCred string `protobuf:"bytes,1,opt,name=signed_cred,json=signedCred,proto3" json:"signed_cred,omitempty"`
+	Error      string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *GenerateSignedCredentialResult) Reset() {
+	*x = GenerateSignedCredentialResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_issuer_v1_issuer_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateSignedCredentialResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSignedCredentialResult) ProtoMessage() {}
+
+func (x *GenerateSignedCredentialResult) ProtoReflect() protoreflect.Message {
+	mi := &file_issuer_v1_issuer_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSignedCredentialResult.ProtoReflect.Descriptor instead.
+func (*GenerateSignedCredentialResult) Descriptor() ([]byte, []int) {
+	return file_issuer_v1_issuer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GenerateSignedCredentialResult) GetSignedCred() string {
+	if x != nil {
+		return x.SignedCred
+	}
+	return ""
+}
+
+func (x *GenerateSignedCredentialResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type GenerateSignedCredentialsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*GenerateSignedCredentialResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GenerateSignedCredentialsResponse) Reset() {
+	*x = GenerateSignedCredentialsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_issuer_v1_issuer_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateSignedCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSignedCredentialsResponse) ProtoMessage() {}
+
+func (x *GenerateSignedCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_issuer_v1_issuer_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSignedCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSignedCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_issuer_v1_issuer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GenerateSignedCredentialsResponse) GetResults() []*GenerateSignedCredentialResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type VerifyProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proof           string   `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	PublicSignals   []string `protobuf:"bytes,2,rep,name=public_signals,json=publicSignals,proto3" json:"public_signals,omitempty"`
+	VerificationKey string   `protobuf:"bytes,3,opt,name=verification_key,json=verificationKey,proto3" json:"verification_key,omitempty"`
+}
+
+func (x *VerifyProofRequest) Reset() {
+	*x = VerifyProofRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_issuer_v1_issuer_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyProofRequest) ProtoMessage() {}
+
+func (x *VerifyProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_issuer_v1_issuer_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyProofRequest.ProtoReflect.Descriptor instead.
+func (*VerifyProofRequest) Descriptor() ([]byte, []int) {
+	return file_issuer_v1_issuer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *VerifyProofRequest) GetProof() string {
+	if x != nil {
+		return x.Proof
+	}
+	return ""
+}
+
+func (x *VerifyProofRequest) GetPublicSignals() []string {
+	if x != nil {
+		return x.PublicSignals
+	}
+	return nil
+}
+
+func (x *VerifyProofRequest) GetVerificationKey() string {
+	if x != nil {
+		return x.VerificationKey
+	}
+	return ""
+}
+
+type VerifyProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid             bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Type              string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Context           string   `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+	Nullifier         string   `protobuf:"bytes,4,opt,name=nullifier,proto3" json:"nullifier,omitempty"`
+	ExternalNullifier string   `protobuf:"bytes,5,opt,name=external_nullifier,json=externalNullifier,proto3" json:"external_nullifier,omitempty"`
+	ExpirationLb      string   `protobuf:"bytes,6,opt,name=expiration_lb,json=expirationLb,proto3" json:"expiration_lb,omitempty"`
+	KeyId             string   `protobuf:"bytes,7,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	IdEqualsTo        string   `protobuf:"bytes,8,opt,name=id_equals_to,json=idEqualsTo,proto3" json:"id_equals_to,omitempty"`
+	ClaimSignals      []string `protobuf:"bytes,9,rep,name=claim_signals,json=claimSignals,proto3" json:"claim_signals,omitempty"`
+}
+
+func (x *VerifyProofResponse) Reset() {
+	*x = VerifyProofResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_issuer_v1_issuer_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyProofResponse) ProtoMessage() {}
+
+func (x *VerifyProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_issuer_v1_issuer_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyProofResponse.ProtoReflect.Descriptor instead.
+func (*VerifyProofResponse) Descriptor() ([]byte, []int) {
+	return file_issuer_v1_issuer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VerifyProofResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyProofResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetContext() string {
+	if x != nil {
+		return x.Context
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetNullifier() string {
+	if x != nil {
+		return x.Nullifier
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetExternalNullifier() string {
+	if x != nil {
+		return x.ExternalNullifier
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetExpirationLb() string {
+	if x != nil {
+		return x.ExpirationLb
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetIdEqualsTo() string {
+	if x != nil {
+		return x.IdEqualsTo
+	}
+	return ""
+}
+
+func (x *VerifyProofResponse) GetClaimSignals() []string {
+	if x != nil {
+		return x.ClaimSignals
+	}
+	return nil
+}
+
 type ClaimType_ScalarType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -826,7 +1149,7 @@ type ClaimType_ScalarType struct {
 func (x *ClaimType_ScalarType) Reset() {
 	*x = ClaimType_ScalarType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuer_v1_issuer_proto_msgTypes[11]
+		mi := &file_issuer_v1_issuer_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -839,7 +1162,7 @@ func (x *ClaimType_ScalarType) String() string {
 func (*ClaimType_ScalarType) ProtoMessage() {}
 
 func (x *ClaimType_ScalarType) ProtoReflect() protoreflect.Message {
-	mi := &file_issuer_v1_issuer_proto_msgTypes[11]
+	mi := &file_issuer_v1_issuer_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1198,7 @@ type ClaimType_PropertyType struct {
 func (x *ClaimType_PropertyType) Reset() {
 	*x = ClaimType_PropertyType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuer_v1_issuer_proto_msgTypes[12]
+		mi := &file_issuer_v1_issuer_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -888,7 +1211,7 @@ func (x *ClaimType_PropertyType) String() string {
 func (*ClaimType_PropertyType) ProtoMessage() {}
 
 func (x *ClaimType_PropertyType) ProtoReflect() protoreflect.Message {
-	mi := &file_issuer_v1_issuer_proto_msgTypes[12]
+	mi := &file_issuer_v1_issuer_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -936,7 +1259,7 @@ type ClaimType_BooleanType struct {
 func (x *ClaimType_BooleanType) Reset() {
 	*x = ClaimType_BooleanType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuer_v1_issuer_proto_msgTypes[13]
+		mi := &file_issuer_v1_issuer_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -949,7 +1272,7 @@ func (x *ClaimType_BooleanType) String() string {
 func (*ClaimType_BooleanType) ProtoMessage() {}
 
 func (x *ClaimType_BooleanType) ProtoReflect() protoreflect.Message {
-	mi := &file_issuer_v1_issuer_proto_msgTypes[13]
+	mi := &file_issuer_v1_issuer_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,7 +1306,7 @@ type ClaimValue_ScalarValue struct {
 func (x *ClaimValue_ScalarValue) Reset() {
 	*x = ClaimValue_ScalarValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuer_v1_issuer_proto_msgTypes[14]
+		mi := &file_issuer_v1_issuer_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -996,7 +1319,7 @@ func (x *ClaimValue_ScalarValue) String() string {
 func (*ClaimValue_ScalarValue) ProtoMessage() {}
 
 func (x *ClaimValue_ScalarValue) ProtoReflect() protoreflect.Message {
-	mi := &file_issuer_v1_issuer_proto_msgTypes[14]
+	mi := &file_issuer_v1_issuer_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,7 +1354,7 @@ type ClaimValue_PropertyValue struct {
 func (x *ClaimValue_PropertyValue) Reset() {
 	*x = ClaimValue_PropertyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuer_v1_issuer_proto_msgTypes[15]
+		mi := &file_issuer_v1_issuer_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1044,7 +1367,7 @@ func (x *ClaimValue_PropertyValue) String() string {
 func (*ClaimValue_PropertyValue) ProtoMessage() {}
 
 func (x *ClaimValue_PropertyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_issuer_v1_issuer_proto_msgTypes[15]
+	mi := &file_issuer_v1_issuer_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,7 +1408,7 @@ type ClaimValue_BoolValue struct {
 func (x *ClaimValue_BoolValue) Reset() {
 	*x = ClaimValue_BoolValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_issuer_v1_issuer_proto_msgTypes[16]
+		mi := &file_issuer_v1_issuer_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1098,7 +1421,7 @@ func (x *ClaimValue_BoolValue) String() string {
 func (*ClaimValue_BoolValue) ProtoMessage() {}
 
 func (x *ClaimValue_BoolValue) ProtoReflect() protoreflect.Message {
-	mi := &file_issuer_v1_issuer_proto_msgTypes[16]
+	mi := &file_issuer_v1_issuer_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1239,46 +1562,104 @@ var file_issuer_v1_issuer_proto_rawDesc = []byte{
 	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72,
-	0x65, 0x64, 0x2a, 0x87, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65,
-	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1f, 0x0a, 0x1b, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x52, 0x10,
-	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x52, 0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x10, 0x02, 0x12,
-	0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e,
-	0x55, 0x4d, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x2a, 0x84, 0x01, 0x0a,
-	0x0c, 0x50, 0x72, 0x6f, 0x70, 0x48, 0x61, 0x73, 0x68, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1e, 0x0a,
-	0x1a, 0x50, 0x52, 0x4f, 0x50, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a,
-	0x17, 0x50, 0x52, 0x4f, 0x50, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f,
-	0x50, 0x4f, 0x53, 0x45, 0x49, 0x44, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52,
-	0x4f, 0x50, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x4b, 0x45, 0x43,
-	0x43, 0x41, 0x4b, 0x32, 0x35, 0x36, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x50,
-	0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f,
-	0x4d, 0x10, 0x03, 0x32, 0xc1, 0x01, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x75, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x69,
-	0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65,
+	0x65, 0x64, 0x22, 0x6a, 0x0a, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65,
 	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x98, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x49, 0x73, 0x73, 0x75, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x2f,
-	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x2f, 0x69, 0x73, 0x73, 0x75, 0x65,
-	0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x3b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0xa2,
-	0x02, 0x03, 0x49, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x09, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x15,
-	0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x57,
+	0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x21, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x7c, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a,
+	0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22,
+	0xa9, 0x02, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
+	0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4e,
+	0x75, 0x6c, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x62, 0x12, 0x15, 0x0a,
+	0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x64, 0x5f, 0x65, 0x71, 0x75, 0x61, 0x6c,
+	0x73, 0x5f, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x64, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x73, 0x54, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x73, 0x2a, 0x87, 0x01, 0x0a, 0x0d,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1f, 0x0a,
+	0x1b, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a,
+	0x0a, 0x16, 0x43, 0x4c, 0x41, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x55,
+	0x4d, 0x5f, 0x53, 0x43, 0x41, 0x4c, 0x41, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4c,
+	0x41, 0x49, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x52,
+	0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x43, 0x4c, 0x41, 0x49,
+	0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x4f, 0x4f, 0x4c,
+	0x45, 0x41, 0x4e, 0x10, 0x03, 0x2a, 0x84, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x70, 0x48, 0x61,
+	0x73, 0x68, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x52, 0x4f, 0x50, 0x5f, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x4f, 0x50, 0x5f, 0x48,
+	0x41, 0x53, 0x48, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4f, 0x53, 0x45, 0x49, 0x44, 0x4f,
+	0x4e, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x52, 0x4f, 0x50, 0x5f, 0x48, 0x41, 0x53, 0x48,
+	0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x4b, 0x45, 0x43, 0x43, 0x41, 0x4b, 0x32, 0x35, 0x36, 0x10,
+	0x02, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x52, 0x4f, 0x50, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x5f, 0x45,
+	0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x03, 0x32, 0x89, 0x03, 0x0a,
+	0x0d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x39,
+	0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x75, 0x0a, 0x18, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2a, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4e, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12,
+	0x1d, 0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x76, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x2b, 0x2e,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x98, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
+	0x2e, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2d, 0x70, 0x61, 0x73, 0x73,
+	0x2f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x2d, 0x70, 0x61, 0x73, 0x73, 0x2f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x3b, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72,
+	0xa2, 0x02, 0x03, 0x49, 0x58, 0x58, 0xaa, 0x02, 0x09, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x09, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x15, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x3a,
+	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1294,54 +1675,65 @@ func file_issuer_v1_issuer_proto_rawDescGZIP() []byte {
 }
 
 var file_issuer_v1_issuer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_issuer_v1_issuer_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_issuer_v1_issuer_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_issuer_v1_issuer_proto_goTypes = []interface{}{
-	(ClaimTypeEnum)(0),                       // 0: issuer.v1.ClaimTypeEnum
-	(PropHashEnum)(0),                        // 1: issuer.v1.PropHashEnum
-	(*PingRequest)(nil),                      // 2: issuer.v1.PingRequest
-	(*PingResponse)(nil),                     // 3: issuer.v1.PingResponse
-	(*ClaimType)(nil),                        // 4: issuer.v1.ClaimType
-	(*ClaimDef)(nil),                         // 5: issuer.v1.ClaimDef
-	(*CredType)(nil),                         // 6: issuer.v1.CredType
-	(*ClaimValue)(nil),                       // 7: issuer.v1.ClaimValue
-	(*Header)(nil),                           // 8: issuer.v1.Header
-	(*Body)(nil),                             // 9: issuer.v1.Body
-	(*AttachmentSet)(nil),                    // 10: issuer.v1.AttachmentSet
-	(*GenerateSignedCredentialRequest)(nil),  // 11: issuer.v1.GenerateSignedCredentialRequest
-	(*GenerateSignedCredentialResponse)(nil), // 12: issuer.v1.GenerateSignedCredentialResponse
-	(*ClaimType_ScalarType)(nil),             // 13: issuer.v1.ClaimType.ScalarType
-	(*ClaimType_PropertyType)(nil),           // 14: issuer.v1.ClaimType.PropertyType
-	(*ClaimType_BooleanType)(nil),            // 15: issuer.v1.ClaimType.BooleanType
-	(*ClaimValue_ScalarValue)(nil),           // 16: issuer.v1.ClaimValue.ScalarValue
-	(*ClaimValue_PropertyValue)(nil),         // 17: issuer.v1.ClaimValue.PropertyValue
-	(*ClaimValue_BoolValue)(nil),             // 18: issuer.v1.ClaimValue.BoolValue
-	nil,                                      // 19: issuer.v1.AttachmentSet.AttachmentsEntry
+	(ClaimTypeEnum)(0),                        // 0: issuer.v1.ClaimTypeEnum
+	(PropHashEnum)(0),                         // 1: issuer.v1.PropHashEnum
+	(*PingRequest)(nil),                       // 2: issuer.v1.PingRequest
+	(*PingResponse)(nil),                      // 3: issuer.v1.PingResponse
+	(*ClaimType)(nil),                         // 4: issuer.v1.ClaimType
+	(*ClaimDef)(nil),                          // 5: issuer.v1.ClaimDef
+	(*CredType)(nil),                          // 6: issuer.v1.CredType
+	(*ClaimValue)(nil),                        // 7: issuer.v1.ClaimValue
+	(*Header)(nil),                            // 8: issuer.v1.Header
+	(*Body)(nil),                              // 9: issuer.v1.Body
+	(*AttachmentSet)(nil),                     // 10: issuer.v1.AttachmentSet
+	(*GenerateSignedCredentialRequest)(nil),   // 11: issuer.v1.GenerateSignedCredentialRequest
+	(*GenerateSignedCredentialResponse)(nil),  // 12: issuer.v1.GenerateSignedCredentialResponse
+	(*GenerateSignedCredentialsRequest)(nil),  // 13: issuer.v1.GenerateSignedCredentialsRequest
+	(*GenerateSignedCredentialResult)(nil),    // 14: issuer.v1.GenerateSignedCredentialResult
+	(*GenerateSignedCredentialsResponse)(nil), // 15: issuer.v1.GenerateSignedCredentialsResponse
+	(*VerifyProofRequest)(nil),                // 16: issuer.v1.VerifyProofRequest
+	(*VerifyProofResponse)(nil),               // 17: issuer.v1.VerifyProofResponse
+	(*ClaimType_ScalarType)(nil),              // 18: issuer.v1.ClaimType.ScalarType
+	(*ClaimType_PropertyType)(nil),            // 19: issuer.v1.ClaimType.PropertyType
+	(*ClaimType_BooleanType)(nil),             // 20: issuer.v1.ClaimType.BooleanType
+	(*ClaimValue_ScalarValue)(nil),            // 21: issuer.v1.ClaimValue.ScalarValue
+	(*ClaimValue_PropertyValue)(nil),          // 22: issuer.v1.ClaimValue.PropertyValue
+	(*ClaimValue_BoolValue)(nil),              // 23: issuer.v1.ClaimValue.BoolValue
+	nil,                                       // 24: issuer.v1.AttachmentSet.AttachmentsEntry
 }
 var file_issuer_v1_issuer_proto_depIdxs = []int32{
-	13, // 0: issuer.v1.ClaimType.scalar_type:type_name -> issuer.v1.ClaimType.ScalarType
-	14, // 1: issuer.v1.ClaimType.property_type:type_name -> issuer.v1.ClaimType.PropertyType
-	15, // 2: issuer.v1.ClaimType.boolean_type:type_name -> issuer.v1.ClaimType.BooleanType
+	18, // 0: issuer.v1.ClaimType.scalar_type:type_name -> issuer.v1.ClaimType.ScalarType
+	19, // 1: issuer.v1.ClaimType.property_type:type_name -> issuer.v1.ClaimType.PropertyType
+	20, // 2: issuer.v1.ClaimType.boolean_type:type_name -> issuer.v1.ClaimType.BooleanType
 	4,  // 3: issuer.v1.ClaimDef.claim_type:type_name -> issuer.v1.ClaimType
 	5,  // 4: issuer.v1.CredType.claims:type_name -> issuer.v1.ClaimDef
-	16, // 5: issuer.v1.ClaimValue.scalar_value:type_name -> issuer.v1.ClaimValue.ScalarValue
-	17, // 6: issuer.v1.ClaimValue.property_value:type_name -> issuer.v1.ClaimValue.PropertyValue
-	18, // 7: issuer.v1.ClaimValue.bool_value:type_name -> issuer.v1.ClaimValue.BoolValue
+	21, // 5: issuer.v1.ClaimValue.scalar_value:type_name -> issuer.v1.ClaimValue.ScalarValue
+	22, // 6: issuer.v1.ClaimValue.property_value:type_name -> issuer.v1.ClaimValue.PropertyValue
+	23, // 7: issuer.v1.ClaimValue.bool_value:type_name -> issuer.v1.ClaimValue.BoolValue
 	6,  // 8: issuer.v1.Body.tp:type_name -> issuer.v1.CredType
 	7,  // 9: issuer.v1.Body.values:type_name -> issuer.v1.ClaimValue
-	19, // 10: issuer.v1.AttachmentSet.attachments:type_name -> issuer.v1.AttachmentSet.AttachmentsEntry
+	24, // 10: issuer.v1.AttachmentSet.attachments:type_name -> issuer.v1.AttachmentSet.AttachmentsEntry
 	8,  // 11: issuer.v1.GenerateSignedCredentialRequest.header:type_name -> issuer.v1.Header
 	9,  // 12: issuer.v1.GenerateSignedCredentialRequest.body:type_name -> issuer.v1.Body
 	10, // 13: issuer.v1.GenerateSignedCredentialRequest.attachments:type_name -> issuer.v1.AttachmentSet
-	1,  // 14: issuer.v1.ClaimType.PropertyType.hash_algorithm:type_name -> issuer.v1.PropHashEnum
-	2,  // 15: issuer.v1.IssuerService.Ping:input_type -> issuer.v1.PingRequest
-	11, // 16: issuer.v1.IssuerService.GenerateSignedCredential:input_type -> issuer.v1.GenerateSignedCredentialRequest
-	3,  // 17: issuer.v1.IssuerService.Ping:output_type -> issuer.v1.PingResponse
-	12, // 18: issuer.v1.IssuerService.GenerateSignedCredential:output_type -> issuer.v1.GenerateSignedCredentialResponse
-	17, // [17:19] is the sub-list for method output_type
-	15, // [15:17] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	11, // 14: issuer.v1.GenerateSignedCredentialsRequest.requests:type_name -> issuer.v1.GenerateSignedCredentialRequest
+	14, // 15: issuer.v1.GenerateSignedCredentialsResponse.results:type_name -> issuer.v1.GenerateSignedCredentialResult
+	1,  // 16: issuer.v1.ClaimType.PropertyType.hash_algorithm:type_name -> issuer.v1.PropHashEnum
+	2,  // 17: issuer.v1.IssuerService.Ping:input_type -> issuer.v1.PingRequest
+	11, // 18: issuer.v1.IssuerService.GenerateSignedCredential:input_type -> issuer.v1.GenerateSignedCredentialRequest
+	16, // 19: issuer.v1.IssuerService.VerifyProof:input_type -> issuer.v1.VerifyProofRequest
+	13, // 20: issuer.v1.IssuerService.GenerateSignedCredentials:input_type -> issuer.v1.GenerateSignedCredentialsRequest
+	3,  // 21: issuer.v1.IssuerService.Ping:output_type -> issuer.v1.PingResponse
+	12, // 22: issuer.v1.IssuerService.GenerateSignedCredential:output_type -> issuer.v1.GenerateSignedCredentialResponse
+	17, // 23: issuer.v1.IssuerService.VerifyProof:output_type -> issuer.v1.VerifyProofResponse
+	15, // 24: issuer.v1.IssuerService.GenerateSignedCredentials:output_type -> issuer.v1.GenerateSignedCredentialsResponse
+	21, // [21:25] is the sub-list for method output_type
+	17, // [17:21] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_issuer_v1_issuer_proto_init() }
@@ -1483,7 +1875,7 @@ func file_issuer_v1_issuer_proto_init() {
 			}
 		}
 		file_issuer_v1_issuer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimType_ScalarType); i {
+			switch v := v.(*GenerateSignedCredentialsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1495,7 +1887,7 @@ func file_issuer_v1_issuer_proto_init() {
 			}
 		}
 		file_issuer_v1_issuer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimType_PropertyType); i {
+			switch v := v.(*GenerateSignedCredentialResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1507,7 +1899,7 @@ func file_issuer_v1_issuer_proto_init() {
 			}
 		}
 		file_issuer_v1_issuer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimType_BooleanType); i {
+			switch v := v.(*GenerateSignedCredentialsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1519,7 +1911,7 @@ func file_issuer_v1_issuer_proto_init() {
 			}
 		}
 		file_issuer_v1_issuer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimValue_ScalarValue); i {
+			switch v := v.(*VerifyProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1531,7 +1923,7 @@ func file_issuer_v1_issuer_proto_init() {
 			}
 		}
 		file_issuer_v1_issuer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClaimValue_PropertyValue); i {
+			switch v := v.(*VerifyProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1543,6 +1935,66 @@ func file_issuer_v1_issuer_proto_init() {
 			}
 		}
 		file_issuer_v1_issuer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimType_ScalarType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_issuer_v1_issuer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimType_PropertyType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_issuer_v1_issuer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimType_BooleanType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_issuer_v1_issuer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimValue_ScalarValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_issuer_v1_issuer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimValue_PropertyValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_issuer_v1_issuer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClaimValue_BoolValue); i {
 			case 0:
 				return &v.state
@@ -1566,15 +2018,15 @@ func file_issuer_v1_issuer_proto_init() {
 		(*ClaimValue_PropertyValue_)(nil),
 		(*ClaimValue_BoolValue_)(nil),
 	}
-	file_issuer_v1_issuer_proto_msgTypes[12].OneofWrappers = []interface{}{}
-	file_issuer_v1_issuer_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_issuer_v1_issuer_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_issuer_v1_issuer_proto_msgTypes[20].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_issuer_v1_issuer_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   18,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type IssuerServiceClient interface {
 	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
 	GenerateSignedCredential(ctx context.Context, in *GenerateSignedCredentialRequest, opts ...grpc.CallOption) (*GenerateSignedCredentialResponse, error)
+	VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error)
+	GenerateSignedCredentials(ctx context.Context, in *GenerateSignedCredentialsRequest, opts ...grpc.CallOption) (*GenerateSignedCredentialsResponse, error)
 }
 
 type issuerServiceClient struct {
@@ -52,12 +54,32 @@ func (c *issuerServiceClient) GenerateSignedCredential(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *issuerServiceClient) VerifyProof(ctx context.Context, in *VerifyProofRequest, opts ...grpc.CallOption) (*VerifyProofResponse, error) {
+	out := new(VerifyProofResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.IssuerService/VerifyProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *issuerServiceClient) GenerateSignedCredentials(ctx context.Context, in *GenerateSignedCredentialsRequest, opts ...grpc.CallOption) (*GenerateSignedCredentialsResponse, error) {
+	out := new(GenerateSignedCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/issuer.v1.IssuerService/GenerateSignedCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IssuerServiceServer is the server API for IssuerService service.
 // All implementations should embed UnimplementedIssuerServiceServer
 // for forward compatibility
 type IssuerServiceServer interface {
 	Ping(context.Context, *PingRequest) (*PingResponse, error)
 	GenerateSignedCredential(context.Context, *GenerateSignedCredentialRequest) (*GenerateSignedCredentialResponse, error)
+	VerifyProof(context.Context, *VerifyProofRequest) (*VerifyProofResponse, error)
+	GenerateSignedCredentials(context.Context, *GenerateSignedCredentialsRequest) (*GenerateSignedCredentialsResponse, error)
 }
 
 // UnimplementedIssuerServiceServer should be embedded to have forward compatible implementations.
@@ -70,6 +92,12 @@ func (UnimplementedIssuerServiceServer) Ping(context.Context, *PingRequest) (*Pi
 func (UnimplementedIssuerServiceServer) GenerateSignedCredential(context.Context, *GenerateSignedCredentialRequest) (*GenerateSignedCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateSignedCredential not implemented")
 }
+func (UnimplementedIssuerServiceServer) VerifyProof(context.Context, *VerifyProofRequest) (*VerifyProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyProof not implemented")
+}
+func (UnimplementedIssuerServiceServer) GenerateSignedCredentials(context.Context, *GenerateSignedCredentialsRequest) (*GenerateSignedCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSignedCredentials not implemented")
+}
 
 // UnsafeIssuerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to IssuerServiceServer will
@@ -118,6 +146,42 @@ func _IssuerService_GenerateSignedCredential_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IssuerService_VerifyProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuerServiceServer).VerifyProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.IssuerService/VerifyProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuerServiceServer).VerifyProof(ctx, req.(*VerifyProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IssuerService_GenerateSignedCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSignedCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IssuerServiceServer).GenerateSignedCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/issuer.v1.IssuerService/GenerateSignedCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IssuerServiceServer).GenerateSignedCredentials(ctx, req.(*GenerateSignedCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // IssuerService_ServiceDesc is the grpc.ServiceDesc for IssuerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -133,6 +197,14 @@ var IssuerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateSignedCredential",
 			Handler:    _IssuerService_GenerateSignedCredential_Handler,
 		},
+		{
+			MethodName: "VerifyProof",
+			Handler:    _IssuerService_VerifyProof_Handler,
+		},
+		{
+			MethodName: "GenerateSignedCredentials",
+			Handler:    _IssuerService_GenerateSignedCredentials_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "issuer/v1/issuer.proto",
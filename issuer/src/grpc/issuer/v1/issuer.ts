@@ -200,6 +200,37 @@ export interface GenerateSignedCredentialResponse {
   signedCred: string;
 }
 
+export interface GenerateSignedCredentialsRequest {
+  requests: GenerateSignedCredentialRequest[];
+}
+
+export interface GenerateSignedCredentialResult {
+  signedCred: string;
+  error: string;
+}
+
+export interface GenerateSignedCredentialsResponse {
+  results: GenerateSignedCredentialResult[];
+}
+
+export interface VerifyProofRequest {
+  proof: string;
+  publicSignals: string[];
+  verificationKey: string;
+}
+
+export interface VerifyProofResponse {
+  valid: boolean;
+  type: string;
+  context: string;
+  nullifier: string;
+  externalNullifier: string;
+  expirationLb: string;
+  keyId: string;
+  idEqualsTo: string;
+  claimSignals: string[];
+}
+
 function createBasePingRequest(): PingRequest {
   return {};
 }
@@ -1575,6 +1606,494 @@ export const GenerateSignedCredentialResponse = {
   },
 };
 
+function createBaseGenerateSignedCredentialsRequest(): GenerateSignedCredentialsRequest {
+  return { requests: [] };
+}
+
+export const GenerateSignedCredentialsRequest = {
+  encode(message: GenerateSignedCredentialsRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.requests) {
+      GenerateSignedCredentialRequest.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateSignedCredentialsRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGenerateSignedCredentialsRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.requests.push(GenerateSignedCredentialRequest.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GenerateSignedCredentialsRequest {
+    return {
+      requests: globalThis.Array.isArray(object?.requests)
+        ? object.requests.map((e: any) => GenerateSignedCredentialRequest.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GenerateSignedCredentialsRequest): unknown {
+    const obj: any = {};
+    if (message.requests?.length) {
+      obj.requests = message.requests.map((e) => GenerateSignedCredentialRequest.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GenerateSignedCredentialsRequest>, I>>(
+    base?: I,
+  ): GenerateSignedCredentialsRequest {
+    return GenerateSignedCredentialsRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GenerateSignedCredentialsRequest>, I>>(
+    object: I,
+  ): GenerateSignedCredentialsRequest {
+    const message = createBaseGenerateSignedCredentialsRequest();
+    message.requests = object.requests?.map((e) => GenerateSignedCredentialRequest.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseGenerateSignedCredentialResult(): GenerateSignedCredentialResult {
+  return { signedCred: "", error: "" };
+}
+
+export const GenerateSignedCredentialResult = {
+  encode(message: GenerateSignedCredentialResult, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.signedCred !== "") {
+      writer.uint32(10).string(message.signedCred);
+    }
+    if (message.error !== "") {
+      writer.uint32(18).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateSignedCredentialResult {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGenerateSignedCredentialResult();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.signedCred = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GenerateSignedCredentialResult {
+    return {
+      signedCred: isSet(object.signedCred) ? globalThis.String(object.signedCred) : "",
+      error: isSet(object.error) ? globalThis.String(object.error) : "",
+    };
+  },
+
+  toJSON(message: GenerateSignedCredentialResult): unknown {
+    const obj: any = {};
+    if (message.signedCred !== "") {
+      obj.signedCred = message.signedCred;
+    }
+    if (message.error !== "") {
+      obj.error = message.error;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GenerateSignedCredentialResult>, I>>(base?: I): GenerateSignedCredentialResult {
+    return GenerateSignedCredentialResult.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GenerateSignedCredentialResult>, I>>(
+    object: I,
+  ): GenerateSignedCredentialResult {
+    const message = createBaseGenerateSignedCredentialResult();
+    message.signedCred = object.signedCred ?? "";
+    message.error = object.error ?? "";
+    return message;
+  },
+};
+
+function createBaseGenerateSignedCredentialsResponse(): GenerateSignedCredentialsResponse {
+  return { results: [] };
+}
+
+export const GenerateSignedCredentialsResponse = {
+  encode(message: GenerateSignedCredentialsResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.results) {
+      GenerateSignedCredentialResult.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GenerateSignedCredentialsResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGenerateSignedCredentialsResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.results.push(GenerateSignedCredentialResult.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): GenerateSignedCredentialsResponse {
+    return {
+      results: globalThis.Array.isArray(object?.results)
+        ? object.results.map((e: any) => GenerateSignedCredentialResult.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: GenerateSignedCredentialsResponse): unknown {
+    const obj: any = {};
+    if (message.results?.length) {
+      obj.results = message.results.map((e) => GenerateSignedCredentialResult.toJSON(e));
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<GenerateSignedCredentialsResponse>, I>>(
+    base?: I,
+  ): GenerateSignedCredentialsResponse {
+    return GenerateSignedCredentialsResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<GenerateSignedCredentialsResponse>, I>>(
+    object: I,
+  ): GenerateSignedCredentialsResponse {
+    const message = createBaseGenerateSignedCredentialsResponse();
+    message.results = object.results?.map((e) => GenerateSignedCredentialResult.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseVerifyProofRequest(): VerifyProofRequest {
+  return { proof: "", publicSignals: [], verificationKey: "" };
+}
+
+export const VerifyProofRequest = {
+  encode(message: VerifyProofRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.proof !== "") {
+      writer.uint32(10).string(message.proof);
+    }
+    for (const v of message.publicSignals) {
+      writer.uint32(18).string(v!);
+    }
+    if (message.verificationKey !== "") {
+      writer.uint32(26).string(message.verificationKey);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VerifyProofRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVerifyProofRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.proof = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.publicSignals.push(reader.string());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.verificationKey = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): VerifyProofRequest {
+    return {
+      proof: isSet(object.proof) ? globalThis.String(object.proof) : "",
+      publicSignals: globalThis.Array.isArray(object?.publicSignals)
+        ? object.publicSignals.map((e: any) => globalThis.String(e))
+        : [],
+      verificationKey: isSet(object.verificationKey) ? globalThis.String(object.verificationKey) : "",
+    };
+  },
+
+  toJSON(message: VerifyProofRequest): unknown {
+    const obj: any = {};
+    if (message.proof !== "") {
+      obj.proof = message.proof;
+    }
+    if (message.publicSignals?.length) {
+      obj.publicSignals = message.publicSignals;
+    }
+    if (message.verificationKey !== "") {
+      obj.verificationKey = message.verificationKey;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<VerifyProofRequest>, I>>(base?: I): VerifyProofRequest {
+    return VerifyProofRequest.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<VerifyProofRequest>, I>>(object: I): VerifyProofRequest {
+    const message = createBaseVerifyProofRequest();
+    message.proof = object.proof ?? "";
+    message.publicSignals = object.publicSignals?.map((e) => e) || [];
+    message.verificationKey = object.verificationKey ?? "";
+    return message;
+  },
+};
+
+function createBaseVerifyProofResponse(): VerifyProofResponse {
+  return {
+    valid: false,
+    type: "",
+    context: "",
+    nullifier: "",
+    externalNullifier: "",
+    expirationLb: "",
+    keyId: "",
+    idEqualsTo: "",
+    claimSignals: [],
+  };
+}
+
+export const VerifyProofResponse = {
+  encode(message: VerifyProofResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.valid !== false) {
+      writer.uint32(8).bool(message.valid);
+    }
+    if (message.type !== "") {
+      writer.uint32(18).string(message.type);
+    }
+    if (message.context !== "") {
+      writer.uint32(26).string(message.context);
+    }
+    if (message.nullifier !== "") {
+      writer.uint32(34).string(message.nullifier);
+    }
+    if (message.externalNullifier !== "") {
+      writer.uint32(42).string(message.externalNullifier);
+    }
+    if (message.expirationLb !== "") {
+      writer.uint32(50).string(message.expirationLb);
+    }
+    if (message.keyId !== "") {
+      writer.uint32(58).string(message.keyId);
+    }
+    if (message.idEqualsTo !== "") {
+      writer.uint32(66).string(message.idEqualsTo);
+    }
+    for (const v of message.claimSignals) {
+      writer.uint32(74).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): VerifyProofResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseVerifyProofResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.valid = reader.bool();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.type = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.context = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.nullifier = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.externalNullifier = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.expirationLb = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.keyId = reader.string();
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.idEqualsTo = reader.string();
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.claimSignals.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): VerifyProofResponse {
+    return {
+      valid: isSet(object.valid) ? globalThis.Boolean(object.valid) : false,
+      type: isSet(object.type) ? globalThis.String(object.type) : "",
+      context: isSet(object.context) ? globalThis.String(object.context) : "",
+      nullifier: isSet(object.nullifier) ? globalThis.String(object.nullifier) : "",
+      externalNullifier: isSet(object.externalNullifier) ? globalThis.String(object.externalNullifier) : "",
+      expirationLb: isSet(object.expirationLb) ? globalThis.String(object.expirationLb) : "",
+      keyId: isSet(object.keyId) ? globalThis.String(object.keyId) : "",
+      idEqualsTo: isSet(object.idEqualsTo) ? globalThis.String(object.idEqualsTo) : "",
+      claimSignals: globalThis.Array.isArray(object?.claimSignals)
+        ? object.claimSignals.map((e: any) => globalThis.String(e))
+        : [],
+    };
+  },
+
+  toJSON(message: VerifyProofResponse): unknown {
+    const obj: any = {};
+    if (message.valid !== false) {
+      obj.valid = message.valid;
+    }
+    if (message.type !== "") {
+      obj.type = message.type;
+    }
+    if (message.context !== "") {
+      obj.context = message.context;
+    }
+    if (message.nullifier !== "") {
+      obj.nullifier = message.nullifier;
+    }
+    if (message.externalNullifier !== "") {
+      obj.externalNullifier = message.externalNullifier;
+    }
+    if (message.expirationLb !== "") {
+      obj.expirationLb = message.expirationLb;
+    }
+    if (message.keyId !== "") {
+      obj.keyId = message.keyId;
+    }
+    if (message.idEqualsTo !== "") {
+      obj.idEqualsTo = message.idEqualsTo;
+    }
+    if (message.claimSignals?.length) {
+      obj.claimSignals = message.claimSignals;
+    }
+    return obj;
+  },
+
+  create<I extends Exact<DeepPartial<VerifyProofResponse>, I>>(base?: I): VerifyProofResponse {
+    return VerifyProofResponse.fromPartial(base ?? ({} as any));
+  },
+  fromPartial<I extends Exact<DeepPartial<VerifyProofResponse>, I>>(object: I): VerifyProofResponse {
+    const message = createBaseVerifyProofResponse();
+    message.valid = object.valid ?? false;
+    message.type = object.type ?? "";
+    message.context = object.context ?? "";
+    message.nullifier = object.nullifier ?? "";
+    message.externalNullifier = object.externalNullifier ?? "";
+    message.expirationLb = object.expirationLb ?? "";
+    message.keyId = object.keyId ?? "";
+    message.idEqualsTo = object.idEqualsTo ?? "";
+    message.claimSignals = object.claimSignals?.map((e) => e) || [];
+    return message;
+  },
+};
+
 export type IssuerServiceService = typeof IssuerServiceService;
 export const IssuerServiceService = {
   ping: {
@@ -1597,11 +2116,33 @@ export const IssuerServiceService = {
       Buffer.from(GenerateSignedCredentialResponse.encode(value).finish()),
     responseDeserialize: (value: Buffer) => GenerateSignedCredentialResponse.decode(value),
   },
+  verifyProof: {
+    path: "/issuer.v1.IssuerService/VerifyProof",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: VerifyProofRequest) => Buffer.from(VerifyProofRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => VerifyProofRequest.decode(value),
+    responseSerialize: (value: VerifyProofResponse) => Buffer.from(VerifyProofResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => VerifyProofResponse.decode(value),
+  },
+  generateSignedCredentials: {
+    path: "/issuer.v1.IssuerService/GenerateSignedCredentials",
+    requestStream: false,
+    responseStream: false,
+    requestSerialize: (value: GenerateSignedCredentialsRequest) =>
+      Buffer.from(GenerateSignedCredentialsRequest.encode(value).finish()),
+    requestDeserialize: (value: Buffer) => GenerateSignedCredentialsRequest.decode(value),
+    responseSerialize: (value: GenerateSignedCredentialsResponse) =>
+      Buffer.from(GenerateSignedCredentialsResponse.encode(value).finish()),
+    responseDeserialize: (value: Buffer) => GenerateSignedCredentialsResponse.decode(value),
+  },
 } as const;
 
 export interface IssuerServiceServer extends UntypedServiceImplementation {
   ping: handleUnaryCall<PingRequest, PingResponse>;
   generateSignedCredential: handleUnaryCall<GenerateSignedCredentialRequest, GenerateSignedCredentialResponse>;
+  verifyProof: handleUnaryCall<VerifyProofRequest, VerifyProofResponse>;
+  generateSignedCredentials: handleUnaryCall<GenerateSignedCredentialsRequest, GenerateSignedCredentialsResponse>;
 }
 
 export interface IssuerServiceClient extends Client {
@@ -1632,6 +2173,36 @@ export interface IssuerServiceClient extends Client {
     options: Partial<CallOptions>,
     callback: (error: ServiceError | null, response: GenerateSignedCredentialResponse) => void,
   ): ClientUnaryCall;
+  verifyProof(
+    request: VerifyProofRequest,
+    callback: (error: ServiceError | null, response: VerifyProofResponse) => void,
+  ): ClientUnaryCall;
+  verifyProof(
+    request: VerifyProofRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: VerifyProofResponse) => void,
+  ): ClientUnaryCall;
+  verifyProof(
+    request: VerifyProofRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: VerifyProofResponse) => void,
+  ): ClientUnaryCall;
+  generateSignedCredentials(
+    request: GenerateSignedCredentialsRequest,
+    callback: (error: ServiceError | null, response: GenerateSignedCredentialsResponse) => void,
+  ): ClientUnaryCall;
+  generateSignedCredentials(
+    request: GenerateSignedCredentialsRequest,
+    metadata: Metadata,
+    callback: (error: ServiceError | null, response: GenerateSignedCredentialsResponse) => void,
+  ): ClientUnaryCall;
+  generateSignedCredentials(
+    request: GenerateSignedCredentialsRequest,
+    metadata: Metadata,
+    options: Partial<CallOptions>,
+    callback: (error: ServiceError | null, response: GenerateSignedCredentialsResponse) => void,
+  ): ClientUnaryCall;
 }
 
 export const IssuerServiceClient = makeGenericClientConstructor(
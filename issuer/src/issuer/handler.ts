@@ -1,6 +1,6 @@
 import * as pb from "../grpc/issuer/v1/issuer.js";
 import { unmarshalHeader, unmarshalBody } from "../issuer/marshal.js";
-import { credential, utils, babyzk } from "@galxe-identity-protocol/sdk";
+import { credential, utils, babyzk, babyzkTypes } from "@galxe-identity-protocol/sdk";
 import { Logger } from "tslog";
 
 const log = new Logger({ name: "protocol" });
@@ -44,3 +44,63 @@ export async function GenerateSignedCredential(
     signedCred: cred.marshal(),
   });
 }
+
+export async function GenerateSignedCredentials(
+  req: pb.GenerateSignedCredentialsRequest,
+  issuerID: string,
+  issuerPK: string
+): Promise<pb.GenerateSignedCredentialsResponse> {
+  log.info("generate signed credentials", req.requests.length);
+
+  // each credential is signed on its own, so one invalid request does not fail the batch
+  const results: pb.GenerateSignedCredentialResult[] = [];
+  for (const request of req.requests) {
+    try {
+      const resp = await GenerateSignedCredential(request, issuerID, issuerPK);
+      results.push(pb.GenerateSignedCredentialResult.create({ signedCred: resp.signedCred }));
+    } catch (err) {
+      log.warn("failed to generate signed credential", request.header, err);
+      results.push(pb.GenerateSignedCredentialResult.create({ error: String(err) }));
+    }
+  }
+
+  return pb.GenerateSignedCredentialsResponse.create({ results });
+}
+
+export async function VerifyProof(req: pb.VerifyProofRequest): Promise<pb.VerifyProofResponse> {
+  const verificationKey = JSON.parse(req.verificationKey);
+
+  // the proof and its signals come from the holder, a malformed proof is invalid rather than an error
+  let proof: babyzkTypes.WholeProof;
+  let valid: boolean;
+  try {
+    proof = {
+      proof: JSON.parse(req.proof),
+      publicSignals: req.publicSignals,
+    };
+    valid = await babyzk.verifyProofRaw(verificationKey, proof);
+  } catch (err) {
+    log.info("malformed proof", err);
+    return pb.VerifyProofResponse.create({ valid: false });
+  }
+  if (!valid) {
+    log.info("invalid proof");
+    return pb.VerifyProofResponse.create({ valid: false });
+  }
+
+  const signal = (s: credential.IntrinsicPublicSignal) => babyzk.defaultPublicSignalGetter(s, proof)?.toString() ?? "";
+  // the claim conditions follow the intrinsic signals, the verifier interprets them with the credential type
+  const intrinsicSignals = Object.values(credential.IntrinsicPublicSignal).filter((v) => typeof v === "number").length;
+
+  return pb.VerifyProofResponse.create({
+    valid: true,
+    type: signal(credential.IntrinsicPublicSignal.Type),
+    context: signal(credential.IntrinsicPublicSignal.Context),
+    nullifier: signal(credential.IntrinsicPublicSignal.Nullifier),
+    externalNullifier: signal(credential.IntrinsicPublicSignal.ExternalNullifier),
+    expirationLb: signal(credential.IntrinsicPublicSignal.ExpirationLb),
+    keyId: signal(credential.IntrinsicPublicSignal.KeyId),
+    idEqualsTo: signal(credential.IntrinsicPublicSignal.IdEqualsTo),
+    claimSignals: req.publicSignals.slice(intrinsicSignals),
+  });
+}
@@ -4,7 +4,7 @@ require("dotenv-flow").config({
 import * as grpc from "@grpc/grpc-js";
 import * as pb from "./grpc/issuer/v1/issuer.js";
 import { Logger } from "tslog";
-import { GenerateSignedCredential } from "./issuer/handler.js";
+import { GenerateSignedCredential, GenerateSignedCredentials, VerifyProof } from "./issuer/handler.js";
 import { createServer, IncomingMessage, ServerResponse } from "http";
 import assert from "assert";
 import { babyzk } from "@galxe-identity-protocol/sdk";
@@ -35,6 +35,24 @@ class Server implements pb.IssuerServiceServer {
       .then(res => callback(null, res))
       .catch(err => callback(err, null));
   }
+
+  public verifyProof(
+    call: grpc.ServerUnaryCall<pb.VerifyProofRequest, pb.VerifyProofResponse>,
+    callback: grpc.sendUnaryData<pb.VerifyProofResponse>
+  ): void {
+    VerifyProof(call.request)
+      .then(res => callback(null, res))
+      .catch(err => callback(err, null));
+  }
+
+  public generateSignedCredentials(
+    call: grpc.ServerUnaryCall<pb.GenerateSignedCredentialsRequest, pb.GenerateSignedCredentialsResponse>,
+    callback: grpc.sendUnaryData<pb.GenerateSignedCredentialsResponse>
+  ): void {
+    GenerateSignedCredentials(call.request, ISSUER_ID!, ISSUER_PK!)
+      .then(res => callback(null, res))
+      .catch(err => callback(err, null));
+  }
 }
 
 async function start() {